@@ -85,6 +85,10 @@ func AdaboostOptions() *AdaboostOptionalParam {
   param.Test = test_data
   
   _, _, predictions, _ := mlpack.Adaboost(param)
+  
+  The unnormalized weighted votes of the weak learners for each class can be
+  computed for new points with the DecisionFunction() method of a trained
+  model.
 
 
   Input parameters:
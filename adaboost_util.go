@@ -0,0 +1,55 @@
+package mlpack
+
+/*
+#cgo CFLAGS: -I./capi -Wall
+#cgo LDFLAGS: -L. -lmlpack_go_adaboost
+#include <capi/adaboost.h>
+#include <stdlib.h>
+*/
+import "C"
+
+import (
+  "errors"
+  "unsafe"
+
+  "gonum.org/v1/gonum/mat"
+)
+
+// NumClasses() returns the number of classes of the model.
+func (m *adaBoostModel) NumClasses() int {
+  return int(C.mlpackAdaBoostNumClasses(m.mem))
+}
+
+// Classes() returns the original label of each class, in the order of the
+// columns of DecisionFunction().
+func (m *adaBoostModel) Classes() []int {
+  output := make([]int, m.NumClasses())
+  for i := range output {
+    output[i] = int(C.mlpackAdaBoostMapping(m.mem, C.size_t(i)))
+  }
+  return output
+}
+
+// DecisionFunction() returns the raw score of each class for each row of
+// points, one row per point and one column per class in the order of
+// Classes().  The score of a class is the sum of the weights of the weak
+// learners that vote for it; the "Probabilities" output of Adaboost() is these
+// scores normalized to sum to 1.
+func (m *adaBoostModel) DecisionFunction(
+    points *mat.Dense) (*mat.Dense, error) {
+  r, d := points.Dims()
+  if d != int(C.mlpackAdaBoostDimensionality(m.mem)) {
+    return nil, errors.New("points must have the dimensionality of the model")
+  }
+
+  // A row-major gonum matrix has the memory layout of the column-major
+  // Armadillo matrix mlpack expects, with one point per column, and likewise
+  // for the scores.
+  k := m.NumClasses()
+  input := mat.DenseCopyOf(points)
+  scores := make([]float64, r * k)
+  C.mlpackAdaBoostDecisionFunction(m.mem,
+      (*C.double)(unsafe.Pointer(&input.RawMatrix().Data[0])), C.size_t(r),
+      (*C.double)(unsafe.Pointer(&scores[0])))
+  return mat.NewDense(r, k, scores), nil
+}
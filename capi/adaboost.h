@@ -11,6 +11,17 @@ extern void *mlpackGetAdaBoostModelPtr(const char* identifier);
 
 extern void mlpackAdaboost();
 
+extern size_t mlpackAdaBoostNumClasses(void* model);
+
+extern size_t mlpackAdaBoostDimensionality(void* model);
+
+extern size_t mlpackAdaBoostMapping(void* model, const size_t i);
+
+extern void mlpackAdaBoostDecisionFunction(void* model,
+                                           const double* points,
+                                           const size_t n,
+                                           double* scores);
+
 #if defined(__cplusplus) || defined(c_plusplus)
 }
 #endif
@@ -11,6 +11,36 @@ extern void *mlpackGetCFModelPtr(const char* identifier);
 
 extern void mlpackCf();
 
+extern size_t mlpackCFRank(void* model);
+
+extern size_t mlpackCFNumUsers(void* model);
+
+extern size_t mlpackCFNumItems(void* model);
+
+extern double *mlpackCFW(void* model);
+
+extern double *mlpackCFH(void* model);
+
+extern size_t mlpackCFRecommend(void* model,
+                                const size_t user,
+                                const size_t numRecs,
+                                size_t* recommendations,
+                                double* scores);
+
+extern void mlpackCFPredict(void* model,
+                            const size_t* users,
+                            const size_t* items,
+                            const size_t n,
+                            double* predictions);
+
+extern size_t mlpackCFRecommendFoldIn(void* model,
+                                      const size_t* items,
+                                      const double* ratings,
+                                      const size_t numRatings,
+                                      const size_t numRecs,
+                                      size_t* recommendations,
+                                      double* scores);
+
 #if defined(__cplusplus) || defined(c_plusplus)
 }
 #endif
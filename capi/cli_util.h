@@ -116,6 +116,40 @@ int mlpackVecIntSize(const char* identifier);
  */
 int mlpackVecStringSize(const char* identifier);
 
+/**
+ * Get the number of inner vectors of the vector<vector<size_t>> parameter.
+ */
+int mlpackVecVecSizeTSize(const char* identifier);
+
+/**
+ * Get the size of the i'th inner vector of the vector<vector<size_t>>
+ * parameter.
+ */
+int mlpackVecVecSizeTElemSize(const char* identifier, const size_t i);
+
+/**
+ * Get the memory pointer of the i'th inner vector of the
+ * vector<vector<size_t>> parameter.
+ */
+void* mlpackGetVecVecSizeTPtr(const char* identifier, const size_t i);
+
+/**
+ * Get the number of inner vectors of the vector<vector<double>> parameter.
+ */
+int mlpackVecVecDoubleSize(const char* identifier);
+
+/**
+ * Get the size of the i'th inner vector of the vector<vector<double>>
+ * parameter.
+ */
+int mlpackVecVecDoubleElemSize(const char* identifier, const size_t i);
+
+/**
+ * Get the memory pointer of the i'th inner vector of the
+ * vector<vector<double>> parameter.
+ */
+void* mlpackGetVecVecDoublePtr(const char* identifier, const size_t i);
+
 /**
  * Set parameter as passed.
  */
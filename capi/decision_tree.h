@@ -1,5 +1,6 @@
 #include <stdint.h>
 #include <stddef.h>
+#include <stdbool.h>
 
 #if defined(__cplusplus) || defined(c_plusplus)
 extern "C" {
@@ -11,6 +12,22 @@ extern void *mlpackGetDecisionTreeModelPtr(const char* identifier);
 
 extern void mlpackDecisionTree();
 
+extern void *mlpackDecisionTreeRoot(void* model);
+
+extern size_t mlpackDecisionTreeNumChildren(void* node);
+
+extern void *mlpackDecisionTreeChild(void* node, const size_t i);
+
+extern size_t mlpackDecisionTreeSplitDimension(void* node);
+
+extern bool mlpackDecisionTreeCategoricalSplit(void* node);
+
+extern double mlpackDecisionTreeSplitValue(void* node);
+
+extern size_t mlpackDecisionTreeNumClasses(void* node);
+
+extern double *mlpackDecisionTreeClassProbabilities(void* node);
+
 #if defined(__cplusplus) || defined(c_plusplus)
 }
 #endif
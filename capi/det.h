@@ -11,6 +11,22 @@ extern void *mlpackGetDTreePtr(const char* identifier);
 
 extern void mlpackDet();
 
+extern void *mlpackDTreeLeft(void* node);
+
+extern void *mlpackDTreeRight(void* node);
+
+extern size_t mlpackDTreeSplitDim(void* node);
+
+extern double mlpackDTreeSplitValue(void* node);
+
+extern size_t mlpackDTreeNumPoints(void* node);
+
+extern double mlpackDTreeLogVolume(void* node);
+
+extern double mlpackDTreeLogNegError(void* node);
+
+extern int mlpackDTreeBucketTag(void* node);
+
 #if defined(__cplusplus) || defined(c_plusplus)
 }
 #endif
@@ -11,6 +11,22 @@ extern void *mlpackGetGMMPtr(const char* identifier);
 
 extern void mlpackGmmTrain();
 
+extern size_t mlpackGMMGaussians(void* gmm);
+
+extern size_t mlpackGMMDimensionality(void* gmm);
+
+extern double *mlpackGMMWeights(void* gmm);
+
+extern double *mlpackGMMMean(void* gmm, const size_t i);
+
+extern double *mlpackGMMCovariance(void* gmm, const size_t i);
+
+extern void *mlpackNewGMM(const size_t gaussians,
+                          const size_t dimensionality,
+                          double* weights,
+                          double* means,
+                          double* covariances);
+
 #if defined(__cplusplus) || defined(c_plusplus)
 }
 #endif
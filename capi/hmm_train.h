@@ -1,5 +1,6 @@
 #include <stdint.h>
 #include <stddef.h>
+#include <stdbool.h>
 
 #if defined(__cplusplus) || defined(c_plusplus)
 extern "C" {
@@ -11,6 +12,45 @@ extern void *mlpackGetHMMModelPtr(const char* identifier);
 
 extern void mlpackHmmTrain();
 
+extern void *mlpackNewDiscreteHMM(const size_t states,
+                                  const size_t symbols,
+                                  double* initial,
+                                  double* transition,
+                                  double* emission);
+
+extern void *mlpackNewGaussianHMM(const size_t states,
+                                  const size_t dimensionality,
+                                  double* initial,
+                                  double* transition,
+                                  double* means,
+                                  double* covariances);
+
+extern void *mlpackNewGMMHMM(const size_t states,
+                             double* initial,
+                             double* transition,
+                             void** emissions,
+                             const bool diagonal);
+
+extern int mlpackHMMModelType(void* model);
+
+extern size_t mlpackHMMStates(void* model);
+
+extern size_t mlpackHMMDimensionality(void* model);
+
+extern double *mlpackHMMInitial(void* model);
+
+extern double *mlpackHMMTransition(void* model);
+
+extern size_t mlpackHMMDiscreteSymbols(void* model);
+
+extern double *mlpackHMMDiscreteEmission(void* model, const size_t state);
+
+extern double *mlpackHMMGaussianMean(void* model, const size_t state);
+
+extern double *mlpackHMMGaussianCovariance(void* model, const size_t state);
+
+extern void *mlpackHMMGMMEmission(void* model, const size_t state);
+
 #if defined(__cplusplus) || defined(c_plusplus)
 }
 #endif
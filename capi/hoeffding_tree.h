@@ -1,5 +1,6 @@
 #include <stdint.h>
 #include <stddef.h>
+#include <stdbool.h>
 
 #if defined(__cplusplus) || defined(c_plusplus)
 extern "C" {
@@ -11,6 +12,38 @@ extern void *mlpackGetHoeffdingTreeModelPtr(const char* identifier);
 
 extern void mlpackHoeffdingTree();
 
+extern void *mlpackNewHoeffdingTreeModel(const bool* categoricals,
+                                         const size_t* numCategories,
+                                         const size_t dimensionality,
+                                         const size_t numClasses,
+                                         const bool infoGain,
+                                         const char* numericSplitStrategy,
+                                         const double confidence,
+                                         const size_t maxSamples,
+                                         const size_t minSamples,
+                                         const size_t bins,
+                                         const size_t observationsBeforeBinning);
+
+extern size_t mlpackHoeffdingTreeDimensionality(void* model);
+
+extern size_t mlpackHoeffdingTreeNumClasses(void* model);
+
+extern size_t mlpackHoeffdingTreeNumCategories(void* model,
+                                               const size_t dimension);
+
+extern void mlpackHoeffdingTreeTrain(void* model,
+                                     const double* point,
+                                     const size_t label);
+
+extern void mlpackHoeffdingTreeTrainBatch(void* model,
+                                          const double* points,
+                                          const size_t* labels,
+                                          const size_t n);
+
+extern size_t mlpackHoeffdingTreeClassify(void* model,
+                                          const double* point,
+                                          double* probability);
+
 #if defined(__cplusplus) || defined(c_plusplus)
 }
 #endif
@@ -11,6 +11,16 @@ extern void *mlpackGetLARSPtr(const char* identifier);
 
 extern void mlpackLars();
 
+extern size_t mlpackLARSBetaSize(void* model);
+
+extern double *mlpackLARSBeta(void* model);
+
+extern size_t mlpackLARSPathLength(void* model);
+
+extern double *mlpackLARSBetaPath(void* model, const size_t i);
+
+extern double *mlpackLARSLambdaPath(void* model);
+
 #if defined(__cplusplus) || defined(c_plusplus)
 }
 #endif
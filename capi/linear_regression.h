@@ -1,5 +1,6 @@
 #include <stdint.h>
 #include <stddef.h>
+#include <stdbool.h>
 
 #if defined(__cplusplus) || defined(c_plusplus)
 extern "C" {
@@ -11,6 +12,14 @@ extern void *mlpackGetLinearRegressionPtr(const char* identifier);
 
 extern void mlpackLinearRegression();
 
+extern size_t mlpackLinearRegressionParametersSize(void* model);
+
+extern double *mlpackLinearRegressionParameters(void* model);
+
+extern bool mlpackLinearRegressionIntercept(void* model);
+
+extern double mlpackLinearRegressionLambda(void* model);
+
 #if defined(__cplusplus) || defined(c_plusplus)
 }
 #endif
@@ -1,5 +1,6 @@
 #include <stdint.h>
 #include <stddef.h>
+#include <stdbool.h>
 
 #if defined(__cplusplus) || defined(c_plusplus)
 extern "C" {
@@ -11,6 +12,16 @@ extern void *mlpackGetLinearSVMModelPtr(const char* identifier);
 
 extern void mlpackLinearSvm();
 
+extern size_t mlpackLinearSVMNumClasses(void* model);
+
+extern size_t mlpackLinearSVMParametersRows(void* model);
+
+extern double *mlpackLinearSVMParameters(void* model);
+
+extern bool mlpackLinearSVMFitIntercept(void* model);
+
+extern size_t mlpackLinearSVMMapping(void* model, const size_t i);
+
 #if defined(__cplusplus) || defined(c_plusplus)
 }
 #endif
@@ -11,6 +11,10 @@ extern void *mlpackGetLogisticRegressionPtr(const char* identifier);
 
 extern void mlpackLogisticRegression();
 
+extern size_t mlpackLogisticRegressionParametersSize(void* model);
+
+extern double *mlpackLogisticRegressionParameters(void* model);
+
 #if defined(__cplusplus) || defined(c_plusplus)
 }
 #endif
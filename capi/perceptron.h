@@ -11,6 +11,16 @@ extern void *mlpackGetPerceptronModelPtr(const char* identifier);
 
 extern void mlpackPerceptron();
 
+extern size_t mlpackPerceptronNumClasses(void* model);
+
+extern size_t mlpackPerceptronDimensionality(void* model);
+
+extern double *mlpackPerceptronWeights(void* model);
+
+extern double *mlpackPerceptronBiases(void* model);
+
+extern size_t mlpackPerceptronMapping(void* model, const size_t i);
+
 #if defined(__cplusplus) || defined(c_plusplus)
 }
 #endif
@@ -1,5 +1,6 @@
 #include <stdint.h>
 #include <stddef.h>
+#include <stdbool.h>
 
 #if defined(__cplusplus) || defined(c_plusplus)
 extern "C" {
@@ -11,6 +12,14 @@ extern void *mlpackGetSoftmaxRegressionPtr(const char* identifier);
 
 extern void mlpackSoftmaxRegression();
 
+extern size_t mlpackSoftmaxRegressionNumClasses(void* model);
+
+extern size_t mlpackSoftmaxRegressionParametersCols(void* model);
+
+extern double *mlpackSoftmaxRegressionParameters(void* model);
+
+extern bool mlpackSoftmaxRegressionFitIntercept(void* model);
+
 #if defined(__cplusplus) || defined(c_plusplus)
 }
 #endif
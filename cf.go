@@ -63,7 +63,12 @@ func CfOptions() *CfOptionalParam {
   The input matrix should be a 3-dimensional matrix of ratings, where the first
   dimension is the user, the second dimension is the item, and the third
   dimension is that user's rating of that item.  Both the users and items should
-  be numeric indices, not names. The indices are assumed to start from 0.
+  be numeric indices, not names. The indices are assumed to start from 0.  To
+  work with string user and item IDs, and to recommend items to users that were
+  not in the training data, use NewCFRecommender().  To measure the accuracy of
+  a model on held-out ratings, use CfEvaluate() or CfCompare().
+  The latent factors of a trained model are available through its UserFactors()
+  and ItemFactors() methods.
   
   A set of query users for which recommendations can be generated may be
   specified with the "Query" parameter; alternately, recommendations may be
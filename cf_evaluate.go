@@ -0,0 +1,307 @@
+package mlpack
+
+/*
+#cgo CFLAGS: -I./capi -Wall
+#cgo LDFLAGS: -L. -lmlpack_go_cf
+#include <capi/cf.h>
+#include <stdlib.h>
+*/
+import "C"
+
+import (
+  "errors"
+  "math"
+  "math/rand"
+  "sort"
+  "unsafe"
+
+  "gonum.org/v1/gonum/mat"
+)
+
+type CfEvaluateOptionalParam struct {
+    K int
+    RelevanceThreshold float64
+    Seed int64
+    Split string
+    TestRatio float64
+    Train *CfOptionalParam
+}
+
+func CfEvaluateOptions() *CfEvaluateOptionalParam {
+  return &CfEvaluateOptionalParam{
+    K: 10,
+    RelevanceThreshold: 0,
+    Seed: 0,
+    Split: "user",
+    TestRatio: 0.2,
+    Train: CfOptions(),
+  }
+}
+
+// The accuracy of a CF model on held-out ratings.  The ranking metrics are
+// averaged over the test users and use binary relevance.
+type CfMetrics struct {
+  RMSE float64
+  MAE float64
+  Precision float64
+  Recall float64
+  MAP float64
+  NDCG float64
+}
+
+/*
+  CfEvaluate() splits the given ratings into a training and a test set, trains
+  a CF model with Cf() on the training set and measures its accuracy on the
+  test set.  The ratings are given as for the "Training" parameter of Cf(): one
+  row per rating holding the user, the item and the rating, optionally followed
+  by a timestamp.
+  
+  With the 'user' split, a "TestRatio" fraction of the ratings of every user
+  with at least two ratings is held out at random.  With the 'time' split, the
+  latest "TestRatio" fraction of each user's ratings, according to the
+  timestamp column, is held out instead.  Held-out ratings of users or items
+  that do not appear in the training set are dropped.
+  
+  The RMSE and MAE of the predicted ratings are computed over all held-out
+  ratings.  Precision@k, recall@k, MAP@k and NDCG@k are computed from the top
+  "K" recommendations of each test user, where a held-out item is relevant if
+  its rating is at least "RelevanceThreshold".  The settings in "Train",
+  including "Algorithm", are used to train the model.  If param is nil, the
+  defaults of CfEvaluateOptions() are used, and if "Train" is nil, those of
+  CfOptions().
+  
+  To compare algorithms on the same split, use CfCompare().
+
+
+  Input parameters:
+
+   - ratings (mat.Dense): The ratings to split.
+   - K (int): Number of recommendations for the ranking metrics.  Default
+        value 10.
+   - RelevanceThreshold (float64): Minimum rating of a relevant held-out
+        item.  Default value 0.
+   - Seed (int64): Random seed for the 'user' split.  Default value 0.
+   - Split (string): How to split the ratings: 'user' or 'time'.  Default
+        value 'user'.
+   - TestRatio (float64): Fraction of the ratings of each user to hold
+        out.  Default value 0.2.
+   - Train (CfOptionalParam): The parameters for Cf().
+
+  Output parameters:
+
+   - metrics (CfMetrics): The accuracy of the model on the test set.
+   - model (cfModel): The model trained on the training set.
+
+ */
+func CfEvaluate(ratings *mat.Dense,
+                param *CfEvaluateOptionalParam) (CfMetrics, cfModel, error) {
+  param = cfEvaluateParam(param)
+  train, test, err := splitRatings(ratings, param)
+  if err != nil {
+    return CfMetrics{}, cfModel{}, err
+  }
+  return evaluateCf(train, test, param.Train, param)
+}
+
+// CfCompare() evaluates each of the given CF algorithms as CfEvaluate() does,
+// using the same training and test sets for all of them.
+func CfCompare(ratings *mat.Dense, algorithms []string,
+               param *CfEvaluateOptionalParam) (map[string]CfMetrics, error) {
+  param = cfEvaluateParam(param)
+  train, test, err := splitRatings(ratings, param)
+  if err != nil {
+    return nil, err
+  }
+
+  output := make(map[string]CfMetrics, len(algorithms))
+  for _, algorithm := range algorithms {
+    p := *param.Train
+    p.Algorithm = algorithm
+    metrics, _, err := evaluateCf(train, test, &p, param)
+    if err != nil {
+      return nil, err
+    }
+    output[algorithm] = metrics
+  }
+  return output, nil
+}
+
+// cfEvaluateParam() returns a copy of param with the defaults filled in for a
+// nil param or a nil "Train".
+func cfEvaluateParam(param *CfEvaluateOptionalParam) *CfEvaluateOptionalParam {
+  p := CfEvaluateOptions()
+  if param != nil {
+    *p = *param
+  }
+  if p.Train == nil {
+    p.Train = CfOptions()
+  }
+  return p
+}
+
+// splitRatings() splits the ratings per user, as described for CfEvaluate().
+func splitRatings(ratings *mat.Dense, param *CfEvaluateOptionalParam) (
+    *mat.Dense, *mat.Dense, error) {
+  n, c := ratings.Dims()
+  if c < 3 {
+    return nil, nil, errors.New("ratings must have user, item and rating " +
+                                "columns")
+  }
+  if param.TestRatio <= 0 || param.TestRatio >= 1 {
+    return nil, nil, errors.New("test ratio must be between 0 and 1")
+  }
+  if param.Split != "user" && param.Split != "time" {
+    return nil, nil, errors.New("split must be 'user' or 'time'")
+  }
+  if param.Split == "time" && c < 4 {
+    return nil, nil, errors.New("the 'time' split needs a timestamp column")
+  }
+
+  byUser := make(map[int][]int)
+  var users []int
+  for i := 0; i < n; i++ {
+    u := int(ratings.At(i, 0))
+    if _, ok := byUser[u]; !ok {
+      users = append(users, u)
+    }
+    byUser[u] = append(byUser[u], i)
+  }
+
+  rng := rand.New(rand.NewSource(param.Seed))
+  isTest := make([]bool, n)
+  for _, u := range users {
+    rows := byUser[u]
+    if len(rows) < 2 {
+      continue
+    }
+    if param.Split == "time" {
+      sort.SliceStable(rows, func(a, b int) bool {
+        return ratings.At(rows[a], 3) < ratings.At(rows[b], 3)
+      })
+    } else {
+      rng.Shuffle(len(rows), func(a, b int) {
+        rows[a], rows[b] = rows[b], rows[a]
+      })
+    }
+
+    held := int(math.Round(param.TestRatio * float64(len(rows))))
+    if held < 1 {
+      held = 1
+    } else if held > len(rows) - 1 {
+      held = len(rows) - 1
+    }
+    for _, row := range rows[len(rows) - held:] {
+      isTest[row] = true
+    }
+  }
+
+  var trainData, testData []float64
+  trainUsers := make(map[int]bool)
+  trainItems := make(map[int]bool)
+  for i := 0; i < n; i++ {
+    if !isTest[i] {
+      trainData = append(trainData, ratings.At(i, 0), ratings.At(i, 1),
+                         ratings.At(i, 2))
+      trainUsers[int(ratings.At(i, 0))] = true
+      trainItems[int(ratings.At(i, 1))] = true
+    }
+  }
+  for i := 0; i < n; i++ {
+    if isTest[i] && trainUsers[int(ratings.At(i, 0))] &&
+        trainItems[int(ratings.At(i, 1))] {
+      testData = append(testData, ratings.At(i, 0), ratings.At(i, 1),
+                        ratings.At(i, 2))
+    }
+  }
+  if len(testData) == 0 {
+    return nil, nil, errors.New("no ratings could be held out")
+  }
+  return mat.NewDense(len(trainData) / 3, 3, trainData),
+         mat.NewDense(len(testData) / 3, 3, testData), nil
+}
+
+// evaluateCf() trains a model on train and computes its metrics on test.
+func evaluateCf(train *mat.Dense, test *mat.Dense, trainParam *CfOptionalParam,
+                param *CfEvaluateOptionalParam) (CfMetrics, cfModel, error) {
+  if param.K < 1 {
+    return CfMetrics{}, cfModel{}, errors.New("K must be positive")
+  }
+
+  p := *trainParam
+  p.Training = train
+  p.Query = nil
+  p.Test = nil
+  p.AllUserRecommendations = false
+  _, model := Cf(&p)
+
+  // Rating accuracy.
+  n, _ := test.Dims()
+  users := make([]C.size_t, n)
+  items := make([]C.size_t, n)
+  for i := 0; i < n; i++ {
+    users[i] = C.size_t(test.At(i, 0))
+    items[i] = C.size_t(test.At(i, 1))
+  }
+  predictions := make([]float64, n)
+  C.mlpackCFPredict(model.mem, &users[0], &items[0], C.size_t(n),
+      (*C.double)(unsafe.Pointer(&predictions[0])))
+
+  var metrics CfMetrics
+  for i := 0; i < n; i++ {
+    diff := predictions[i] - test.At(i, 2)
+    metrics.RMSE += diff * diff
+    metrics.MAE += math.Abs(diff)
+  }
+  metrics.RMSE = math.Sqrt(metrics.RMSE / float64(n))
+  metrics.MAE /= float64(n)
+
+  // Ranking accuracy.
+  relevant := make(map[int]map[int]bool)
+  for i := 0; i < n; i++ {
+    u := int(test.At(i, 0))
+    if relevant[u] == nil {
+      relevant[u] = make(map[int]bool)
+    }
+    if test.At(i, 2) >= param.RelevanceThreshold {
+      relevant[u][int(test.At(i, 1))] = true
+    }
+  }
+
+  recs := make([]C.size_t, param.K)
+  scores := make([]float64, param.K)
+  numUsers := 0
+  for u, rel := range relevant {
+    if len(rel) == 0 {
+      continue
+    }
+    found := int(C.mlpackCFRecommend(model.mem, C.size_t(u),
+        C.size_t(param.K), &recs[0],
+        (*C.double)(unsafe.Pointer(&scores[0]))))
+
+    hits, precisionSum, dcg, idcg := 0, 0.0, 0.0, 0.0
+    for j := 0; j < found; j++ {
+      if rel[int(recs[j])] {
+        hits++
+        precisionSum += float64(hits) / float64(j + 1)
+        dcg += 1 / math.Log2(float64(j + 2))
+      }
+    }
+    for j := 0; j < len(rel) && j < param.K; j++ {
+      idcg += 1 / math.Log2(float64(j + 2))
+    }
+
+    metrics.Precision += float64(hits) / float64(param.K)
+    metrics.Recall += float64(hits) / float64(len(rel))
+    metrics.MAP += precisionSum / math.Min(float64(len(rel)),
+                                           float64(param.K))
+    metrics.NDCG += dcg / idcg
+    numUsers++
+  }
+  if numUsers > 0 {
+    metrics.Precision /= float64(numUsers)
+    metrics.Recall /= float64(numUsers)
+    metrics.MAP /= float64(numUsers)
+    metrics.NDCG /= float64(numUsers)
+  }
+  return metrics, model, nil
+}
@@ -0,0 +1,141 @@
+package mlpack
+
+/*
+#cgo CFLAGS: -I./capi -Wall
+#cgo LDFLAGS: -L. -lmlpack_go_cf
+#include <capi/cf.h>
+#include <stdlib.h>
+*/
+import "C"
+
+import (
+  "errors"
+  "unsafe"
+
+  "gonum.org/v1/gonum/mat"
+)
+
+// Rank() returns the number of latent factors of the model.  For 'BiasSVD' and
+// 'SVDPP' this includes the bias terms that mlpack stores with the factors.
+func (m *cfModel) Rank() int {
+  return int(C.mlpackCFRank(m.mem))
+}
+
+// NumUsers() returns the number of users the model was trained on.
+func (m *cfModel) NumUsers() int {
+  return int(C.mlpackCFNumUsers(m.mem))
+}
+
+// NumItems() returns the number of items the model was trained on.
+func (m *cfModel) NumItems() int {
+  return int(C.mlpackCFNumItems(m.mem))
+}
+
+// UserFactors() returns a copy of the latent factors of the users (the H
+// matrix of the decomposition), one row per user and one column per factor.
+func (m *cfModel) UserFactors() *mat.Dense {
+  // H is stored as a column-major rank x users matrix, so its memory already
+  // holds one user per row.
+  rank, users := m.Rank(), m.NumUsers()
+  data := copyDoubles(unsafe.Pointer(C.mlpackCFH(m.mem)), rank * users)
+  return mat.NewDense(users, rank, data)
+}
+
+// ItemFactors() returns a copy of the latent factors of the items (the W
+// matrix of the decomposition), one row per item and one column per factor.
+func (m *cfModel) ItemFactors() *mat.Dense {
+  // W is stored as a column-major items x rank matrix.
+  rank, items := m.Rank(), m.NumItems()
+  data := copyDoubles(unsafe.Pointer(C.mlpackCFW(m.mem)), rank * items)
+  output := mat.NewDense(items, rank, nil)
+  output.Copy(mat.NewDense(rank, items, data).T())
+  return output
+}
+
+// SaveFactors() writes the user and item factors to the given CSV files with
+// Save(), one row per user or item, for use outside of mlpack.
+func (m *cfModel) SaveFactors(userFile string, itemFile string) error {
+  if err := Save(userFile, m.UserFactors()); err != nil {
+    return err
+  }
+  return Save(itemFile, m.ItemFactors())
+}
+
+/*
+  SimilarItems() finds the k items nearest to each of the given items in the
+  latent space of the model, using the Euclidean distance between item factors.
+  The search is done with Knn(); param may be used to choose its tree type and
+  other options, and its "InputModel", "K", "Query", "Reference",
+  "TrueDistances" and "TrueNeighbors" parameters are ignored.  If param is nil,
+  the defaults of KnnOptions() are used.
+
+  The outputs are organized such that element i holds the neighbors of
+  items[i] and their distances, nearest first.  An item is never returned as its
+  own neighbor.
+
+ */
+func (m *cfModel) SimilarItems(items []int, k int,
+    param *KnnOptionalParam) ([][]int, [][]float64, error) {
+  factors := m.ItemFactors()
+  n, rank := factors.Dims()
+  if k < 1 || k >= n {
+    return nil, nil, errors.New("k must be between 1 and the number of " +
+                                "items minus 1")
+  }
+
+  query := mat.NewDense(len(items), rank, nil)
+  for i, item := range items {
+    if item < 0 || item >= n {
+      return nil, nil, errors.New("item index out of range")
+    }
+    query.SetRow(i, factors.RawRowView(item))
+  }
+
+  p := KnnOptions()
+  if param != nil {
+    *p = *param
+  }
+  p.InputModel = nil
+  p.K = k + 1
+  p.Query = query
+  p.Reference = factors
+  p.TrueDistances = nil
+  p.TrueNeighbors = nil
+  distances, neighbors, _ := Knn(p)
+
+  outNeighbors := make([][]int, len(items))
+  outDistances := make([][]float64, len(items))
+  for i, item := range items {
+    for j := 0; j < k + 1 && len(outNeighbors[i]) < k; j++ {
+      neighbor := int(neighbors.At(i, j))
+      if neighbor == item {
+        continue
+      }
+      outNeighbors[i] = append(outNeighbors[i], neighbor)
+      outDistances[i] = append(outDistances[i], distances.At(i, j))
+    }
+  }
+  return outNeighbors, outDistances, nil
+}
+
+// SimilarItems() returns the n items nearest to the given item in the latent
+// space of the model, as cfModel.SimilarItems() does.  The score of each item
+// is its distance to the given item.
+func (r *CFRecommender) SimilarItems(item string,
+                                     n int) ([]Recommendation, error) {
+  i, ok := r.itemIndex[item]
+  if !ok {
+    return nil, errors.New("unknown item " + item)
+  }
+
+  neighbors, distances, err := r.Model.SimilarItems([]int{i}, n, nil)
+  if err != nil {
+    return nil, err
+  }
+  output := make([]Recommendation, len(neighbors[0]))
+  for j, neighbor := range neighbors[0] {
+    output[j] = Recommendation{Item: r.itemIDs[neighbor],
+                               Score: distances[0][j]}
+  }
+  return output, nil
+}
@@ -0,0 +1,172 @@
+package mlpack
+
+/*
+#cgo CFLAGS: -I./capi -Wall
+#cgo LDFLAGS: -L. -lmlpack_go_cf
+#include <capi/cf.h>
+#include <stdlib.h>
+*/
+import "C"
+
+import (
+  "errors"
+  "unsafe"
+
+  "gonum.org/v1/gonum/mat"
+)
+
+// A single rating of an item by a user, both identified by arbitrary strings.
+type Rating struct {
+  User string
+  Item string
+  Value float64
+}
+
+// A recommended item with its predicted rating.
+type Recommendation struct {
+  Item string
+  Score float64
+}
+
+// A collaborative filtering recommender that maps string user and item IDs to
+// the numeric indices used by Cf().  Users that were not in the training data
+// can be folded in from a handful of ratings without retraining.
+type CFRecommender struct {
+  Model cfModel
+  userIndex map[string]int
+  itemIndex map[string]int
+  itemIDs []string
+  foldedIn map[string]map[string]float64
+}
+
+// NewCFRecommender() maps the IDs of the given ratings to indices and trains a
+// CF model on them with Cf().  The "Training", "Query" and "Test" parameters
+// are ignored.  If param is nil, the defaults of CfOptions() are used.
+func NewCFRecommender(ratings []Rating,
+                      param *CfOptionalParam) (*CFRecommender, error) {
+  if len(ratings) == 0 {
+    return nil, errors.New("no ratings given")
+  }
+
+  r := &CFRecommender{
+    userIndex: make(map[string]int),
+    itemIndex: make(map[string]int),
+    foldedIn: make(map[string]map[string]float64),
+  }
+  training := mat.NewDense(len(ratings), 3, nil)
+  for i, rating := range ratings {
+    u, ok := r.userIndex[rating.User]
+    if !ok {
+      u = len(r.userIndex)
+      r.userIndex[rating.User] = u
+    }
+    it, ok := r.itemIndex[rating.Item]
+    if !ok {
+      it = len(r.itemIDs)
+      r.itemIndex[rating.Item] = it
+      r.itemIDs = append(r.itemIDs, rating.Item)
+    }
+    training.SetRow(i, []float64{float64(u), float64(it), rating.Value})
+  }
+
+  p := *CfOptions()
+  if param != nil {
+    p = *param
+  }
+  p.Training = training
+  p.Query = nil
+  p.Test = nil
+  p.AllUserRecommendations = false
+  _, r.Model = Cf(&p)
+  return r, nil
+}
+
+// AddUser() folds a new user into the recommender from their ratings, keyed by
+// item ID, so that Recommend() can be used for them.  The ratings are copied,
+// and items that were not in the training data are ignored.  The model is not
+// retrained.
+func (r *CFRecommender) AddUser(user string, ratings map[string]float64) error {
+  if _, ok := r.userIndex[user]; ok {
+    return errors.New("user '" + user + "' is already in the training data")
+  }
+  r.foldedIn[user] = make(map[string]float64, len(ratings))
+  for item, value := range ratings {
+    r.foldedIn[user][item] = value
+  }
+  return nil
+}
+
+// Recommend() returns the top n recommendations for the given user, best
+// first, with their predicted ratings.  Items the user has already rated are
+// excluded.  The user must be in the training data or added with AddUser().
+func (r *CFRecommender) Recommend(user string, n int) ([]Recommendation, error) {
+  if n < 1 {
+    return nil, errors.New("n must be positive")
+  }
+  if u, ok := r.userIndex[user]; ok {
+    recs := make([]C.size_t, n)
+    scores := make([]float64, n)
+    found := C.mlpackCFRecommend(r.Model.mem, C.size_t(u), C.size_t(n),
+        &recs[0], (*C.double)(unsafe.Pointer(&scores[0])))
+    return r.recommendations(recs[:found], scores), nil
+  }
+  if ratings, ok := r.foldedIn[user]; ok {
+    return r.RecommendFor(ratings, n)
+  }
+  return nil, errors.New("unknown user '" + user + "'")
+}
+
+// RecommendFor() returns the top n recommendations, best first, for an
+// anonymous user with the given ratings, keyed by item ID.  The user is folded
+// into the model from their ratings without retraining.  Rated items and items
+// that were not in the training data are excluded.
+func (r *CFRecommender) RecommendFor(ratings map[string]float64,
+                                     n int) ([]Recommendation, error) {
+  if n < 1 {
+    return nil, errors.New("n must be positive")
+  }
+  items := make([]C.size_t, 0, len(ratings))
+  values := make([]float64, 0, len(ratings))
+  for item, value := range ratings {
+    if i, ok := r.itemIndex[item]; ok {
+      items = append(items, C.size_t(i))
+      values = append(values, value)
+    }
+  }
+  if len(items) == 0 {
+    return nil, errors.New("none of the rated items are known")
+  }
+
+  recs := make([]C.size_t, n)
+  scores := make([]float64, n)
+  found := C.mlpackCFRecommendFoldIn(r.Model.mem, &items[0],
+      (*C.double)(unsafe.Pointer(&values[0])), C.size_t(len(items)),
+      C.size_t(n), &recs[0], (*C.double)(unsafe.Pointer(&scores[0])))
+  return r.recommendations(recs[:found], scores), nil
+}
+
+// UserIndex() returns the index of a training user in the model.
+func (r *CFRecommender) UserIndex(user string) (int, bool) {
+  u, ok := r.userIndex[user]
+  return u, ok
+}
+
+// ItemIndex() returns the index of an item in the model.
+func (r *CFRecommender) ItemIndex(item string) (int, bool) {
+  i, ok := r.itemIndex[item]
+  return i, ok
+}
+
+// ItemID() returns the ID of the item with the given index in the model.
+func (r *CFRecommender) ItemID(index int) string {
+  return r.itemIDs[index]
+}
+
+func (r *CFRecommender) recommendations(recs []C.size_t,
+                                        scores []float64) []Recommendation {
+  output := make([]Recommendation, len(recs))
+  for i, rec := range recs {
+    output[i] = Recommendation{Item: r.itemIDs[int(rec)], Score: scores[i]}
+  }
+  return output
+}
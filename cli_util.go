@@ -4,6 +4,7 @@ package mlpack
 #cgo CFLAGS: -I. -I/capi -g -Wall
 #cgo LDFLAGS: -L${SRCDIR} -Wl,-rpath,${SRCDIR} -lgo_util
 #include <capi/cli_util.h>
+#include <stdlib.h>
 */
 import "C"
 
@@ -144,3 +145,75 @@ func getParamVecString(identifier string) []string {
   }
   return data
 }
+
+func getParamVecVecIntSize(identifier string) int {
+  id := C.CString(identifier)
+  defer C.free(unsafe.Pointer(id))
+  return int(C.mlpackVecVecSizeTSize(id))
+}
+
+// getParamVecVecIntAt() takes the identifier as a C string, so that a caller
+// reading every element allocates it only once.
+func getParamVecVecIntAt(identifier *C.char, i int) []int {
+  e := int(C.mlpackVecVecSizeTElemSize(identifier, C.size_t(i)))
+  output := make([]int, e)
+  if e == 0 {
+    return output
+  }
+
+  var v mlpackVectorType
+  v.mem = C.mlpackGetVecVecSizeTPtr(identifier, C.size_t(i))
+  // size_t is not guaranteed to match Go's int, so copy element-wise.
+  data := (*[1<<30 - 1]C.size_t)(v.mem)
+  for j := 0; j < e; j++ {
+    output[j] = int(data[j])
+  }
+  return output
+}
+
+func getParamVecVecInt(identifier string) [][]int {
+  e := getParamVecVecIntSize(identifier)
+  id := C.CString(identifier)
+  defer C.free(unsafe.Pointer(id))
+
+  output := make([][]int, e)
+  for i := 0; i < e; i++ {
+    output[i] = getParamVecVecIntAt(id, i)
+  }
+  return output
+}
+
+func getParamVecVecDoubleSize(identifier string) int {
+  id := C.CString(identifier)
+  defer C.free(unsafe.Pointer(id))
+  return int(C.mlpackVecVecDoubleSize(id))
+}
+
+// getParamVecVecDoubleAt() takes the identifier as a C string, as
+// getParamVecVecIntAt() does.
+func getParamVecVecDoubleAt(identifier *C.char, i int) []float64 {
+  e := int(C.mlpackVecVecDoubleElemSize(identifier, C.size_t(i)))
+  output := make([]float64, e)
+  if e == 0 {
+    return output
+  }
+
+  var v mlpackVectorType
+  v.mem = C.mlpackGetVecVecDoublePtr(identifier, C.size_t(i))
+  // Copy out of the C++ vector, since it is freed by clearSettings().
+  data := (*[1<<30 - 1]float64)(v.mem)
+  copy(output, data[:e])
+  return output
+}
+
+func getParamVecVecDouble(identifier string) [][]float64 {
+  e := getParamVecVecDoubleSize(identifier)
+  id := C.CString(identifier)
+  defer C.free(unsafe.Pointer(id))
+
+  output := make([][]float64, e)
+  for i := 0; i < e; i++ {
+    output[i] = getParamVecVecDoubleAt(id, i)
+  }
+  return output
+}
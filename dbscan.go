@@ -44,7 +44,10 @@ func DbscanOptions() *DbscanOptionalParam {
   
   The "Assignments" and "Centroids" output parameters may be used to save the
   output of the clustering. "Assignments" contains the cluster assignments of
-  each point, and "Centroids" contains the centroids of each cluster.
+  each point, and "Centroids" contains the centroids of each cluster.  Noise
+  points are assigned the largest representable index, SIZE_MAX.  To get the
+  assignments as an []int with noise as -1, along with the core samples and a
+  model that can assign new points, use DbscanFit() instead.
   
   The range search may be controlled with the "TreeType", "SingleMode", and
   "Naive" parameters.  "TreeType" can control the type of tree used for range
@@ -90,6 +93,27 @@ func DbscanOptions() *DbscanOptionalParam {
 
  */
 func Dbscan(input *mat.Dense, param *DbscanOptionalParam) (*mat.Dense, *mat.Dense) {
+  runDbscan(input, param, false)
+
+  // Initialize result variable and get output.
+  var assignmentsPtr mlpackArma
+  assignments := assignmentsPtr.armaToGonumUrow("assignments")
+  var centroidsPtr mlpackArma
+  centroids := centroidsPtr.armaToGonumMat("centroids")
+
+  // Clear settings.
+  clearSettings()
+
+  // Return output(s).
+  return assignments, centroids
+}
+
+// runDbscan clusters the input.  The indices of the core samples, which
+// DbscanFit() keeps to assign new points, are only requested when coreSamples
+// is set.  The assignments and centroids are read by the caller before it
+// clears the settings.
+func runDbscan(input *mat.Dense, param *DbscanOptionalParam,
+               coreSamples bool) {
   resetTimers()
   enableTimers()
   disableBacktrace()
@@ -146,19 +170,10 @@ func Dbscan(input *mat.Dense, param *DbscanOptionalParam) (*mat.Dense, *mat.Dens
   // Mark all output options as passed.
   setPassed("assignments")
   setPassed("centroids")
+  if coreSamples {
+    setPassed("core_samples")
+  }
 
   // Call the mlpack program.
   C.mlpackDbscan()
-
-  // Initialize result variable and get output.
-  var assignmentsPtr mlpackArma
-  assignments := assignmentsPtr.armaToGonumUrow("assignments")
-  var centroidsPtr mlpackArma
-  centroids := centroidsPtr.armaToGonumMat("centroids")
-
-  // Clear settings.
-  clearSettings()
-
-  // Return output(s).
-  return assignments, centroids
 }
@@ -0,0 +1,133 @@
+package mlpack
+
+import (
+  "math"
+
+  "gonum.org/v1/gonum/mat"
+)
+
+// A DBSCAN clustering that can assign unseen points to the learned clusters.
+// Each row of CorePoints is a core sample of the training data, CoreSamples
+// holds its index in the training data and CoreLabels its cluster.
+type dbscanModel struct {
+  CorePoints *mat.Dense
+  CoreSamples []int
+  CoreLabels []int
+  Centroids *mat.Dense
+  Epsilon float64
+  Naive bool
+  SingleMode bool
+  TreeType string
+  tree *rsModel
+}
+
+/*
+  DbscanFit() runs Dbscan() on the input and returns the clustering as a model,
+  along with the cluster of each input point.  Noise points are labeled -1.  If
+  param is nil, the defaults of DbscanOptions() are used.
+
+  For example, to cluster input with a radius of 0.5 and then assign the points
+  in new_points to the clusters found, one could call
+
+  // Initialize optional parameters for Dbscan().
+  param := mlpack.DbscanOptions()
+  param.Epsilon = 0.5
+
+  model, labels := mlpack.DbscanFit(input, param)
+  newLabels := model.Assign(new_points)
+
+ */
+func DbscanFit(input *mat.Dense,
+               param *DbscanOptionalParam) (*dbscanModel, []int) {
+  if param == nil {
+    param = DbscanOptions()
+  }
+  runDbscan(input, param, true)
+
+  var assignmentsPtr mlpackArma
+  assignments := assignmentsPtr.armaToGonumUrow("assignments")
+  var centroidsPtr mlpackArma
+  centroids := centroidsPtr.armaToGonumMat("centroids")
+  coreSamples := getParamVecInt("core_samples")
+
+  n, d := input.Dims()
+  labels := make([]int, n)
+  for i := range labels {
+    labels[i] = dbscanLabel(assignments.At(i, 0), n)
+  }
+
+  // The core samples are copied out of mlpack memory before it is released.
+  m := &dbscanModel{
+    CoreSamples: append([]int(nil), coreSamples...),
+    CoreLabels: make([]int, len(coreSamples)),
+    Centroids: centroids,
+    Epsilon: param.Epsilon,
+    Naive: param.Naive,
+    SingleMode: param.SingleMode,
+    TreeType: param.TreeType,
+  }
+  clearSettings()
+
+  if len(m.CoreSamples) > 0 {
+    m.CorePoints = mat.NewDense(len(m.CoreSamples), d, nil)
+  }
+  for i, sample := range m.CoreSamples {
+    m.CoreLabels[i] = labels[sample]
+    m.CorePoints.SetRow(i, input.RawRowView(sample))
+  }
+  return m, labels
+}
+
+/*
+  Assign() returns the cluster of each row of points: the cluster of the nearest
+  core sample within Epsilon, or -1 if there is none.  The range search tree
+  over the core samples is built on the first call and reused afterwards.
+
+ */
+func (m *dbscanModel) Assign(points *mat.Dense) []int {
+  r, _ := points.Dims()
+  labels := make([]int, r)
+  for i := range labels {
+    labels[i] = -1
+  }
+  if m.CorePoints == nil {
+    return labels
+  }
+
+  param := RangeSearchOptions()
+  param.Max = m.Epsilon
+  param.Naive = m.Naive
+  param.Query = points
+  param.SingleMode = m.SingleMode
+  if m.tree != nil {
+    param.InputModel = m.tree
+  } else {
+    param.Reference = m.CorePoints
+    param.TreeType = m.TreeType
+  }
+
+  distances, neighbors, model := RangeSearch(param)
+  if m.tree == nil {
+    m.tree = &model
+  }
+
+  for i := range labels {
+    best := math.Inf(1)
+    for j, neighbor := range neighbors[i] {
+      if distances[i][j] < best {
+        best = distances[i][j]
+        labels[i] = m.CoreLabels[neighbor]
+      }
+    }
+  }
+  return labels
+}
+
+// dbscanLabel() converts an assignment returned by Dbscan() to a label, mapping
+// the SIZE_MAX used for noise to -1.
+func dbscanLabel(assignment float64, n int) int {
+  if assignment >= float64(n) {
+    return -1
+  }
+  return int(assignment)
+}
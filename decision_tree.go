@@ -93,6 +93,10 @@ func DecisionTreeOptions() *DecisionTreeOptionalParam {
   param.TestLabels = test_labels
   
   _, predictions, _ := mlpack.DecisionTree(param)
+  
+  The structure of a trained tree can be inspected from Go with its Tree()
+  method, and exported with the DOT() and JSON() methods of the returned root
+  node.
 
 
   Input parameters:
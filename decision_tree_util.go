@@ -0,0 +1,193 @@
+package mlpack
+
+/*
+#cgo CFLAGS: -I./capi -Wall
+#cgo LDFLAGS: -L. -lmlpack_go_decision_tree
+#include <capi/decision_tree.h>
+#include <stdlib.h>
+*/
+import "C"
+
+import (
+  "encoding/json"
+  "fmt"
+  "strings"
+  "unsafe"
+
+  "gonum.org/v1/gonum/mat"
+)
+
+// A single node of a trained decision tree.  Internal nodes split on dimension
+// SplitDimension.  For a numeric split, points with a value less than or equal
+// to SplitValue go to the first child and the others to the second.  For a
+// categorical split, points go to the child indexed by their category.  Leaves
+// have no children and hold the class probabilities of their training points.
+// NumSamples is only set by CountSamples().
+type DecisionTreeNode struct {
+  SplitDimension int `json:"splitDimension"`
+  Categorical bool `json:"categorical"`
+  SplitValue float64 `json:"splitValue"`
+  ClassProbabilities []float64 `json:"classProbabilities,omitempty"`
+  NumSamples int `json:"numSamples"`
+  Children []*DecisionTreeNode `json:"children,omitempty"`
+}
+
+// Tree() copies the structure of the trained decision tree into Go and returns
+// its root node.
+func (m *decisionTreeModel) Tree() *DecisionTreeNode {
+  if m.mem == nil {
+    return nil
+  }
+  return newDecisionTreeNode(C.mlpackDecisionTreeRoot(m.mem))
+}
+
+func newDecisionTreeNode(node unsafe.Pointer) *DecisionTreeNode {
+  children := int(C.mlpackDecisionTreeNumChildren(node))
+  n := &DecisionTreeNode{}
+  if children == 0 {
+    classes := int(C.mlpackDecisionTreeNumClasses(node))
+    ptr := unsafe.Pointer(C.mlpackDecisionTreeClassProbabilities(node))
+    n.ClassProbabilities = copyDoubles(ptr, classes)
+    return n
+  }
+
+  n.SplitDimension = int(C.mlpackDecisionTreeSplitDimension(node))
+  n.Categorical = bool(C.mlpackDecisionTreeCategoricalSplit(node))
+  if !n.Categorical {
+    n.SplitValue = float64(C.mlpackDecisionTreeSplitValue(node))
+  }
+  n.Children = make([]*DecisionTreeNode, children)
+  for i := range n.Children {
+    n.Children[i] = newDecisionTreeNode(
+        C.mlpackDecisionTreeChild(node, C.size_t(i)))
+  }
+  return n
+}
+
+// IsLeaf() returns true if the node has no children.
+func (n *DecisionTreeNode) IsLeaf() bool {
+  return len(n.Children) == 0
+}
+
+// Direction() returns the index of the child the given point goes to.
+func (n *DecisionTreeNode) Direction(point []float64) int {
+  if n.Categorical {
+    return int(point[n.SplitDimension])
+  }
+  if point[n.SplitDimension] <= n.SplitValue {
+    return 0
+  }
+  return 1
+}
+
+// CountSamples() sets NumSamples of the node and all of its descendants to the
+// number of rows of points that reach them.  For trees trained on categorical
+// data, the categorical dimensions of points must hold the mapped categories,
+// as in the "Data" of a matrixWithInfo.
+func (n *DecisionTreeNode) CountSamples(points *mat.Dense) {
+  n.Walk(func(node *DecisionTreeNode, depth int) bool {
+    node.NumSamples = 0
+    return true
+  })
+
+  r, _ := points.Dims()
+  for i := 0; i < r; i++ {
+    point := points.RawRowView(i)
+    node := n
+    for {
+      node.NumSamples++
+      if node.IsLeaf() {
+        break
+      }
+      d := node.Direction(point)
+      if d < 0 || d >= len(node.Children) {
+        break
+      }
+      node = node.Children[d]
+    }
+  }
+}
+
+// Walk() visits the node and all of its descendants in pre-order, giving the
+// depth of each node to fn.  If fn returns false the children of that node are
+// skipped.
+func (n *DecisionTreeNode) Walk(fn func(node *DecisionTreeNode, depth int) bool) {
+  n.walk(0, fn)
+}
+
+func (n *DecisionTreeNode) walk(depth int,
+                                fn func(*DecisionTreeNode, int) bool) {
+  if !fn(n, depth) {
+    return
+  }
+  for _, child := range n.Children {
+    child.walk(depth + 1, fn)
+  }
+}
+
+// FeatureUsage() returns the number of splits on each dimension in the tree.
+func (n *DecisionTreeNode) FeatureUsage() map[int]int {
+  usage := make(map[int]int)
+  n.Walk(func(node *DecisionTreeNode, depth int) bool {
+    if !node.IsLeaf() {
+      usage[node.SplitDimension]++
+    }
+    return true
+  })
+  return usage
+}
+
+// JSON() returns the tree rooted at the node as JSON.
+func (n *DecisionTreeNode) JSON() ([]byte, error) {
+  return json.MarshalIndent(n, "", "  ")
+}
+
+// DOT() returns the tree rooted at the node in the Graphviz DOT format. 
+// featureNames, if not nil, gives the name of each dimension.
+func (n *DecisionTreeNode) DOT(featureNames []string) string {
+  var b strings.Builder
+  b.WriteString("digraph DecisionTree {\n  node [shape=box];\n")
+  id := 0
+  var write func(node *DecisionTreeNode) int
+  write = func(node *DecisionTreeNode) int {
+    self := id
+    id++
+
+    feature := fmt.Sprintf("x%d", node.SplitDimension)
+    if featureNames != nil && node.SplitDimension < len(featureNames) {
+      feature = featureNames[node.SplitDimension]
+    }
+
+    var label string
+    switch {
+    case node.IsLeaf():
+      label = fmt.Sprintf("probabilities = %v", node.ClassProbabilities)
+    case node.Categorical:
+      label = fmt.Sprintf("%s (categorical)", feature)
+    default:
+      label = fmt.Sprintf("%s <= %g", feature, node.SplitValue)
+    }
+    if node.NumSamples != 0 {
+      label += fmt.Sprintf("\\nsamples = %d", node.NumSamples)
+    }
+    fmt.Fprintf(&b, "  n%d [label=%s];\n", self, dotQuote(label))
+
+    for i, child := range node.Children {
+      c := write(child)
+      edge := fmt.Sprintf("%d", i)
+      if !node.Categorical {
+        edge = [...]string{"yes", "no"}[i]
+      }
+      fmt.Fprintf(&b, "  n%d -> n%d [label=%s];\n", self, c, dotQuote(edge))
+    }
+    return self
+  }
+  write(n)
+  b.WriteString("}\n")
+  return b.String()
+}
+
+// dotQuote() quotes a DOT label, keeping escape sequences such as \n.
+func dotQuote(s string) string {
+  return "\"" + strings.ReplaceAll(s, "\"", "\\\"") + "\""
+}
@@ -48,19 +48,22 @@ func DetOptions() *DetOptionalParam {
   estimates for each training point may be saved with the "TrainingSetEstimates"
   output parameter.
   
-  Enabling path printing for each node outputs the path from the root node to a
-  leaf for each entry in the test set, or training set (if a test set is not
-  provided).  Strings like 'LRLRLR' (indicating that traversal went to the left
-  child, then the right child, then the left child, and so forth) will be
-  output. If 'lr-id' or 'id-lr' are given as the "PathFormat" parameter, then
-  the ID (tag) of every node along the path will be printed after or before the
-  L or R character indicating the direction of traversal, respectively.
+  The tag of the leaf each entry in the test set, or training set (if a test
+  set is not provided), falls into is returned in the "Tags" output, and the
+  number of points that fell into each leaf in the "TagCounters" output.  The
+  path from the root node to that leaf is returned in the "Paths" output. 
+  Strings like 'LRLRLR' (indicating that traversal went to the left child, then
+  the right child, then the left child, and so forth) will be output. If 'lr-id'
+  or 'id-lr' are given as the "PathFormat" parameter, then the ID (tag) of every
+  node along the path will be given after or before the L or R character
+  indicating the direction of traversal, respectively.
   
   This program also can provide density estimates for a set of test points,
   specified in the "Test" parameter.  The density estimation tree used for this
   task will be the tree that was trained on the given training points, or a tree
   given as the parameter "InputModel".  The density estimates for the test
-  points may be saved using the "TestSetEstimates" output parameter.
+  points may be saved using the "TestSetEstimates" output parameter.  To only
+  score new points with an already trained tree, Density() may be used.
 
 
   Input parameters:
@@ -72,8 +75,8 @@ func DetOptions() *DetOptionalParam {
         grown DET.  Default value 10.
    - MinLeafSize (int): The minimum size of a leaf in the unpruned, fully
         grown DET.  Default value 5.
-   - PathFormat (string): The format of path printing: 'lr', 'id-lr', or
-        'lr-id'.  Default value 'lr'.
+   - PathFormat (string): The format of the returned paths: 'lr', 'id-lr',
+        or 'lr-id'.  Default value 'lr'.
    - SkipPruning (bool): Whether to bypass the pruning process and output
         the unpruned tree only.
    - Test (mat.Dense): A set of test points to estimate the density of.
@@ -86,10 +89,10 @@ func DetOptions() *DetOptionalParam {
 
    - outputModel (dTree): Output to save trained density estimation tree
         to.
-   - tagCountersFile (string): The file to output the number of points
-        that went to each leaf.  Default value ''.
-   - tagFile (string): The file to output the tags (and possibly paths)
-        for each sample in the test set.  Default value ''.
+   - paths ([]string): The path from the root to the leaf for each sample
+        in the test set, in the format given by "PathFormat".
+   - tagCounters ([]int): The number of points that went to each leaf.
+   - tags ([]int): The tag of the leaf for each sample in the test set.
    - testSetEstimates (mat.Dense): The output estimates on the test set
         from the final optimally pruned tree.
    - trainingSetEstimates (mat.Dense): The output density estimates on the
@@ -98,7 +101,7 @@ func DetOptions() *DetOptionalParam {
         feature.
 
  */
-func Det(param *DetOptionalParam) (dTree, string, string, *mat.Dense, *mat.Dense, *mat.Dense) {
+func Det(param *DetOptionalParam) (dTree, []string, []int, []int, *mat.Dense, *mat.Dense, *mat.Dense) {
   resetTimers()
   enableTimers()
   disableBacktrace()
@@ -162,8 +165,9 @@ func Det(param *DetOptionalParam) (dTree, string, string, *mat.Dense, *mat.Dense
 
   // Mark all output options as passed.
   setPassed("output_model")
-  setPassed("tag_counters_file")
-  setPassed("tag_file")
+  setPassed("paths")
+  setPassed("tag_counters")
+  setPassed("tags")
   setPassed("test_set_estimates")
   setPassed("training_set_estimates")
   setPassed("vi")
@@ -174,8 +178,9 @@ func Det(param *DetOptionalParam) (dTree, string, string, *mat.Dense, *mat.Dense
   // Initialize result variable and get output.
   var outputModel dTree
   outputModel.getDTree("output_model")
-  tagCountersFile := getParamString("tag_counters_file")
-  tagFile := getParamString("tag_file")
+  paths := getParamVecString("paths")
+  tagCounters := getParamVecInt("tag_counters")
+  tags := getParamVecInt("tags")
   var testSetEstimatesPtr mlpackArma
   testSetEstimates := testSetEstimatesPtr.armaToGonumMat("test_set_estimates")
   var trainingSetEstimatesPtr mlpackArma
@@ -187,5 +192,5 @@ func Det(param *DetOptionalParam) (dTree, string, string, *mat.Dense, *mat.Dense
   clearSettings()
 
   // Return output(s).
-  return outputModel, tagCountersFile, tagFile, testSetEstimates, trainingSetEstimates, vi
+  return outputModel, paths, tagCounters, tags, testSetEstimates, trainingSetEstimates, vi
 }
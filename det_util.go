@@ -0,0 +1,100 @@
+package mlpack
+
+/*
+#cgo CFLAGS: -I./capi -Wall
+#cgo LDFLAGS: -L. -lmlpack_go_det
+#include <capi/det.h>
+#include <stdlib.h>
+*/
+import "C"
+
+import (
+  "math"
+  "unsafe"
+
+  "gonum.org/v1/gonum/mat"
+)
+
+// A single node of a trained density estimation tree.  Internal nodes split
+// the points on dimension SplitDim at SplitValue: points with a value less than
+// or equal to SplitValue go to the Left child, the others to the Right child.
+// Leaves have nil children and a non-negative Tag.
+type DTreeNode struct {
+  SplitDim int
+  SplitValue float64
+  NumPoints int
+  LogVolume float64
+  LogNegError float64
+  Tag int
+  Left *DTreeNode
+  Right *DTreeNode
+}
+
+// IsLeaf() returns true if the node has no children.
+func (n *DTreeNode) IsLeaf() bool {
+  return n.Left == nil && n.Right == nil
+}
+
+// Tree() copies the split structure of the trained density estimation tree
+// into Go and returns its root node.
+func (m *dTree) Tree() *DTreeNode {
+  if m.mem == nil {
+    return nil
+  }
+  return newDTreeNode(m.mem)
+}
+
+func newDTreeNode(node unsafe.Pointer) *DTreeNode {
+  n := &DTreeNode{
+    SplitDim: int(C.mlpackDTreeSplitDim(node)),
+    SplitValue: float64(C.mlpackDTreeSplitValue(node)),
+    NumPoints: int(C.mlpackDTreeNumPoints(node)),
+    LogVolume: float64(C.mlpackDTreeLogVolume(node)),
+    LogNegError: float64(C.mlpackDTreeLogNegError(node)),
+    Tag: int(C.mlpackDTreeBucketTag(node)),
+  }
+  if left := C.mlpackDTreeLeft(node); left != nil {
+    n.Left = newDTreeNode(left)
+  }
+  if right := C.mlpackDTreeRight(node); right != nil {
+    n.Right = newDTreeNode(right)
+  }
+  return n
+}
+
+// Density() returns the density estimate of the node, that is, the fraction of
+// the training points in the node divided by its volume.  totalPoints is the
+// number of points in the root node.
+func (n *DTreeNode) Density(totalPoints int) float64 {
+  return math.Exp(math.Log(float64(n.NumPoints)) -
+                  math.Log(float64(totalPoints)) - n.LogVolume)
+}
+
+// Walk() visits the node and all of its descendants in pre-order, giving the
+// path from the root ('L' and 'R' characters) to fn.  If fn returns false the
+// children of that node are skipped.
+func (n *DTreeNode) Walk(fn func(node *DTreeNode, path string) bool) {
+  n.walk("", fn)
+}
+
+func (n *DTreeNode) walk(path string, fn func(*DTreeNode, string) bool) {
+  if !fn(n, path) {
+    return
+  }
+  if n.Left != nil {
+    n.Left.walk(path + "L", fn)
+  }
+  if n.Right != nil {
+    n.Right.walk(path + "R", fn)
+  }
+}
+
+// Density() returns the density estimates of the given points, one per row,
+// from an already trained density estimation tree without retraining it.
+func Density(model *dTree, points *mat.Dense) *mat.Dense {
+  param := DetOptions()
+  param.InputModel = model
+  param.Test = points
+  _, _, _, _, testSetEstimates, _, _ := Det(param)
+  return testSetEstimates
+}
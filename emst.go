@@ -52,6 +52,10 @@ func EmstOptions() *EmstOptionalParam {
   edge.  The first dimension corresponds to the lesser index of the edge; the
   second dimension corresponds to the greater index of the edge; and the third
   column corresponds to the distance between the two points.
+  
+  The spanning tree gives the single-linkage hierarchical clustering of the
+  dataset; NewDendrogram() builds the dendrogram from it, which can then be cut
+  into clusters.
 
 
   Input parameters:
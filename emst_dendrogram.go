@@ -0,0 +1,252 @@
+package mlpack
+
+import (
+  "encoding/json"
+  "errors"
+  "sort"
+  "strconv"
+  "strings"
+
+  "gonum.org/v1/gonum/mat"
+)
+
+// A single merge of a Dendrogram.  Clusters are numbered as in SciPy's linkage
+// matrices: indices below the number of points are the points themselves, and
+// the cluster formed by merge i has index NumPoints + i.
+type DendrogramMerge struct {
+  Left int
+  Right int
+  Distance float64
+  Size int
+}
+
+// A single-linkage hierarchical clustering of a dataset.  The merges are
+// ordered by increasing distance.
+type Dendrogram struct {
+  NumPoints int
+  Merges []DendrogramMerge
+}
+
+// A node of a Dendrogram, as exported by JSON().  Leaves have a Point and no
+// children.
+type DendrogramNode struct {
+  Point *int `json:"point,omitempty"`
+  Distance float64 `json:"distance"`
+  Size int `json:"size"`
+  Children []*DendrogramNode `json:"children,omitempty"`
+}
+
+/*
+  NewDendrogram() builds the single-linkage dendrogram of a dataset from its
+  minimum spanning tree, as returned by Emst().  Merging the edges of the
+  spanning tree in order of increasing length gives exactly the single-linkage
+  hierarchy, so no further distance computations are needed.
+
+  For example, to cluster the points of data into 5 clusters, one could call
+
+  spanning_tree := mlpack.Emst(data, mlpack.EmstOptions())
+  dendrogram, _ := mlpack.NewDendrogram(spanning_tree)
+  labels, _ := dendrogram.CutClusters(5)
+
+ */
+func NewDendrogram(spanningTree *mat.Dense) (*Dendrogram, error) {
+  r, c := spanningTree.Dims()
+  if c != 3 {
+    return nil, errors.New("spanning tree must have three columns")
+  }
+
+  edges := make([]int, r)
+  for i := range edges {
+    edges[i] = i
+  }
+  sort.SliceStable(edges, func(a, b int) bool {
+    return spanningTree.At(edges[a], 2) < spanningTree.At(edges[b], 2)
+  })
+
+  n := r + 1
+  d := &Dendrogram{NumPoints: n, Merges: make([]DendrogramMerge, 0, r)}
+  uf := newUnionFind(n)
+  // The cluster index of the component whose root is each point.
+  cluster := make([]int, n)
+  for i := range cluster {
+    cluster[i] = i
+  }
+
+  for _, e := range edges {
+    a := int(spanningTree.At(e, 0))
+    b := int(spanningTree.At(e, 1))
+    if a < 0 || a >= n || b < 0 || b >= n {
+      return nil, errors.New("spanning tree has an edge index out of range")
+    }
+    ra, rb := uf.find(a), uf.find(b)
+    if ra == rb {
+      return nil, errors.New("spanning tree contains a cycle")
+    }
+
+    d.Merges = append(d.Merges, DendrogramMerge{
+      Left: cluster[ra],
+      Right: cluster[rb],
+      Distance: spanningTree.At(e, 2),
+      Size: uf.size[ra] + uf.size[rb],
+    })
+    root := uf.union(ra, rb)
+    cluster[root] = n + len(d.Merges) - 1
+  }
+  return d, nil
+}
+
+// SingleLinkage() computes the minimum spanning tree of input with Emst() and
+// returns its single-linkage dendrogram.  If param is nil, the defaults of
+// EmstOptions() are used.
+func SingleLinkage(input *mat.Dense,
+                   param *EmstOptionalParam) (*Dendrogram, error) {
+  if param == nil {
+    param = EmstOptions()
+  }
+  return NewDendrogram(Emst(input, param))
+}
+
+// CutDistance() returns the cluster label of each point when all merges at a
+// distance of at most distance are applied.  Labels are numbered from 0 in
+// order of the first point of each cluster.
+func (d *Dendrogram) CutDistance(distance float64) []int {
+  merges := sort.Search(len(d.Merges), func(i int) bool {
+    return d.Merges[i].Distance > distance
+  })
+  return d.labels(merges)
+}
+
+// CutClusters() returns the cluster label of each point when the dendrogram is
+// cut into k clusters.  Labels are numbered as for CutDistance().
+func (d *Dendrogram) CutClusters(k int) ([]int, error) {
+  if k < 1 || k > d.NumPoints {
+    return nil, errors.New("number of clusters must be between 1 and the " +
+                           "number of points")
+  }
+  return d.labels(d.NumPoints - k), nil
+}
+
+// labels() applies the first merges merges and labels the resulting clusters.
+func (d *Dendrogram) labels(merges int) []int {
+  uf := newUnionFind(d.NumPoints)
+  // A point of each cluster created so far, to find its component.
+  member := make([]int, d.NumPoints + merges)
+  for i := 0; i < d.NumPoints; i++ {
+    member[i] = i
+  }
+  for i, m := range d.Merges[:merges] {
+    uf.union(uf.find(member[m.Left]), uf.find(member[m.Right]))
+    member[d.NumPoints + i] = member[m.Left]
+  }
+
+  labels := make([]int, d.NumPoints)
+  ids := make(map[int]int)
+  for i := range labels {
+    root := uf.find(i)
+    label, ok := ids[root]
+    if !ok {
+      label = len(ids)
+      ids[root] = label
+    }
+    labels[i] = label
+  }
+  return labels
+}
+
+// Root() returns the dendrogram as a tree of nodes.  If the dataset has a
+// single point, the root is that point's leaf.
+func (d *Dendrogram) Root() *DendrogramNode {
+  nodes := make([]*DendrogramNode, d.NumPoints + len(d.Merges))
+  for i := 0; i < d.NumPoints; i++ {
+    point := i
+    nodes[i] = &DendrogramNode{Point: &point, Size: 1}
+  }
+  for i, m := range d.Merges {
+    nodes[d.NumPoints + i] = &DendrogramNode{
+      Distance: m.Distance,
+      Size: m.Size,
+      Children: []*DendrogramNode{nodes[m.Left], nodes[m.Right]},
+    }
+  }
+  return nodes[len(nodes) - 1]
+}
+
+// JSON() returns the tree of Root() as indented JSON.
+func (d *Dendrogram) JSON() ([]byte, error) {
+  return json.MarshalIndent(d.Root(), "", "  ")
+}
+
+// Newick() returns the dendrogram in Newick format.  Leaves are named by
+// names[i] if names is given, and by the point index otherwise.  Branch lengths
+// are the differences between the merge distances of a node and its parent.
+func (d *Dendrogram) Newick(names []string) (string, error) {
+  if names != nil && len(names) != d.NumPoints {
+    return "", errors.New("number of names must match the number of points")
+  }
+
+  var b strings.Builder
+  var write func(node *DendrogramNode, parent float64)
+  write = func(node *DendrogramNode, parent float64) {
+    if node.Point != nil {
+      if names != nil {
+        b.WriteString(newickQuote(names[*node.Point]))
+      } else {
+        b.WriteString(strconv.Itoa(*node.Point))
+      }
+    } else {
+      b.WriteByte('(')
+      write(node.Children[0], node.Distance)
+      b.WriteByte(',')
+      write(node.Children[1], node.Distance)
+      b.WriteByte(')')
+    }
+    b.WriteByte(':')
+    b.WriteString(strconv.FormatFloat(parent - node.Distance, 'g', -1, 64))
+  }
+
+  root := d.Root()
+  write(root, root.Distance)
+  b.WriteByte(';')
+  return b.String(), nil
+}
+
+// newickQuote() quotes a Newick leaf name if it contains special characters.
+func newickQuote(s string) string {
+  if s != "" && !strings.ContainsAny(s, " \t\n()[]':;,") {
+    return s
+  }
+  return "'" + strings.Replace(s, "'", "''", -1) + "'"
+}
+
+// A disjoint-set forest with path compression and union by size.
+type unionFind struct {
+  parent []int
+  size []int
+}
+
+func newUnionFind(n int) *unionFind {
+  uf := &unionFind{parent: make([]int, n), size: make([]int, n)}
+  for i := range uf.parent {
+    uf.parent[i] = i
+    uf.size[i] = 1
+  }
+  return uf
+}
+
+func (uf *unionFind) find(i int) int {
+  for uf.parent[i] != i {
+    uf.parent[i] = uf.parent[uf.parent[i]]
+    i = uf.parent[i]
+  }
+  return i
+}
+
+// union() joins the components with roots a and b and returns the new root.
+func (uf *unionFind) union(a int, b int) int {
+  if uf.size[a] < uf.size[b] {
+    a, b = b, a
+  }
+  uf.parent[b] = a
+  uf.size[a] += uf.size[b]
+  return a
+}
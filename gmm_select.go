@@ -0,0 +1,139 @@
+package mlpack
+
+import (
+  "errors"
+  "math"
+
+  "gonum.org/v1/gonum/mat"
+)
+
+type GmmSelectOptionalParam struct {
+    CompareCovariances bool
+    Criterion string
+    Train *GmmTrainOptionalParam
+}
+
+func GmmSelectOptions() *GmmSelectOptionalParam {
+  return &GmmSelectOptionalParam{
+    CompareCovariances: false,
+    Criterion: "bic",
+    Train: GmmTrainOptions(),
+  }
+}
+
+// The scores of one candidate model trained by GmmSelect().
+type GmmCandidate struct {
+  Gaussians int
+  DiagonalCovariance bool
+  NumParameters int
+  LogLikelihood float64
+  BIC float64
+  AIC float64
+  Model gmm
+}
+
+/*
+  GmmSelect() trains a GMM with GmmTrain() for each number of Gaussians between
+  minGaussians and maxGaussians (inclusive) and returns the candidate with the
+  lowest information criterion, along with the scores of every candidate.
+  
+  The settings in "Train" are used for every candidate, including "Trials" and
+  "DiagonalCovariance".  If "CompareCovariances" is given, both a diagonal and
+  a full covariance model are trained for each number of Gaussians.  The
+  "Criterion" parameter selects the criterion used to pick the best model:
+  'bic' or 'aic'.  If param is nil, the defaults of GmmSelectOptions() are
+  used, and if "Train" is nil, those of GmmTrainOptions().
+  
+  The candidates are trained one after another: the binding layer keeps the
+  parameters of a call in global state, so calls into mlpack cannot run in
+  parallel.
+
+
+  Input parameters:
+
+   - input (mat.Dense): The training data.
+   - minGaussians (int): The smallest number of Gaussians to try.
+   - maxGaussians (int): The largest number of Gaussians to try.
+   - CompareCovariances (bool): If set, train both diagonal and full
+        covariance models for each number of Gaussians.
+   - Criterion (string): The criterion used to choose the best model: 'bic'
+        or 'aic'.  Default value 'bic'.
+   - Train (GmmTrainOptionalParam): The parameters for GmmTrain().
+
+  Output parameters:
+
+   - best (gmm): The candidate model with the lowest criterion.
+   - candidates ([]GmmCandidate): The scores of every candidate, in
+        training order.
+
+ */
+func GmmSelect(input *mat.Dense, minGaussians int, maxGaussians int,
+               param *GmmSelectOptionalParam) (gmm, []GmmCandidate, error) {
+  if minGaussians < 1 || maxGaussians < minGaussians {
+    return gmm{}, nil, errors.New("invalid range of Gaussians")
+  }
+  p := GmmSelectOptions()
+  if param != nil {
+    *p = *param
+  }
+  if p.Train == nil {
+    p.Train = GmmTrainOptions()
+  }
+  param = p
+  if param.Criterion != "bic" && param.Criterion != "aic" {
+    return gmm{}, nil, errors.New("criterion must be 'bic' or 'aic'")
+  }
+
+  covariances := []bool{param.Train.DiagonalCovariance}
+  if param.CompareCovariances {
+    covariances = []bool{false, true}
+  }
+
+  n, d := input.Dims()
+  var candidates []GmmCandidate
+  best := -1
+  for g := minGaussians; g <= maxGaussians; g++ {
+    for _, diagonal := range covariances {
+      train := *param.Train
+      train.DiagonalCovariance = diagonal
+      model := GmmTrain(g, input, &train)
+
+      logLikelihood, err := model.LogLikelihood(input)
+      if err != nil {
+        return gmm{}, nil, err
+      }
+
+      // Weights (which sum to 1), means and covariances.
+      p := (g - 1) + g * d
+      if diagonal {
+        p += g * d
+      } else {
+        p += g * d * (d + 1) / 2
+      }
+
+      candidates = append(candidates, GmmCandidate{
+        Gaussians: g,
+        DiagonalCovariance: diagonal,
+        NumParameters: p,
+        LogLikelihood: logLikelihood,
+        BIC: -2 * logLikelihood + float64(p) * math.Log(float64(n)),
+        AIC: -2 * logLikelihood + 2 * float64(p),
+        Model: model,
+      })
+
+      last := len(candidates) - 1
+      if best == -1 || candidates[last].score(param.Criterion) <
+          candidates[best].score(param.Criterion) {
+        best = last
+      }
+    }
+  }
+  return candidates[best].Model, candidates, nil
+}
+
+func (c *GmmCandidate) score(criterion string) float64 {
+  if criterion == "aic" {
+    return c.AIC
+  }
+  return c.BIC
+}
@@ -101,6 +101,10 @@ func GmmTrainOptions() *GmmTrainOptionalParam {
   param.InputModel = &gmm
   
   new_gmm := mlpack.GmmTrain(data2, 6, param)
+  
+  The weights, means and covariances of a trained GMM can be read with its
+  Weights(), Means() and Covariances() methods, and a GMM can be built from
+  explicit parameters with NewGMM().
 
 
   Input parameters:
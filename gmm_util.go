@@ -0,0 +1,177 @@
+package mlpack
+
+/*
+#cgo CFLAGS: -I./capi -Wall
+#cgo LDFLAGS: -L. -lmlpack_go_gmm_train
+#include <capi/gmm_train.h>
+#include <stdlib.h>
+*/
+import "C"
+
+import (
+  "errors"
+  "math"
+  "unsafe"
+
+  "gonum.org/v1/gonum/floats"
+  "gonum.org/v1/gonum/mat"
+  "gonum.org/v1/gonum/stat/distmv"
+)
+
+// NewGMM() builds a Gaussian mixture model from explicit parameters, for
+// example parameters estimated elsewhere.  The model can be used with
+// GmmProbability(), GmmGenerate() and as the "InputModel" of GmmTrain().  All
+// components must have the dimensionality of the first mean, and the weights
+// must sum to 1.
+func NewGMM(weights []float64, means []*mat.VecDense,
+            covs []*mat.SymDense) (*gmm, error) {
+  g := len(weights)
+  if g == 0 || len(means) != g || len(covs) != g {
+    return nil, errors.New("weights, means and covariances must have the " +
+                           "same, non-zero length")
+  }
+  if math.Abs(floats.Sum(weights) - 1) > 1e-5 {
+    return nil, errors.New("weights must sum to 1")
+  }
+  for i := 0; i < g; i++ {
+    if means[i] == nil || covs[i] == nil {
+      return nil, errors.New("means and covariances must not be nil")
+    }
+  }
+
+  d := means[0].Len()
+  meanData := make([]float64, 0, g * d)
+  covData := make([]float64, 0, g * d * d)
+  for i := 0; i < g; i++ {
+    if means[i].Len() != d || covs[i].SymmetricDim() != d {
+      return nil, errors.New("all means and covariances must have the same " +
+                             "dimensionality")
+    }
+    for j := 0; j < d; j++ {
+      meanData = append(meanData, means[i].AtVec(j))
+    }
+    // Covariances are symmetric, so row-major and column-major order match.
+    for j := 0; j < d; j++ {
+      for k := 0; k < d; k++ {
+        covData = append(covData, covs[i].At(j, k))
+      }
+    }
+  }
+
+  var m gmm
+  m.mem = C.mlpackNewGMM(C.size_t(g), C.size_t(d),
+      (*C.double)(unsafe.Pointer(&weights[0])),
+      (*C.double)(unsafe.Pointer(&meanData[0])),
+      (*C.double)(unsafe.Pointer(&covData[0])))
+  return &m, nil
+}
+
+// Gaussians() returns the number of components of the model.
+func (m *gmm) Gaussians() int {
+  return int(C.mlpackGMMGaussians(m.mem))
+}
+
+// Dimensionality() returns the dimensionality of the model.
+func (m *gmm) Dimensionality() int {
+  return int(C.mlpackGMMDimensionality(m.mem))
+}
+
+// Weights() returns a copy of the weight of each component.
+func (m *gmm) Weights() []float64 {
+  return copyDoubles(unsafe.Pointer(C.mlpackGMMWeights(m.mem)), m.Gaussians())
+}
+
+// Means() returns a copy of the mean of each component.
+func (m *gmm) Means() []*mat.VecDense {
+  d := m.Dimensionality()
+  output := make([]*mat.VecDense, m.Gaussians())
+  for i := range output {
+    ptr := unsafe.Pointer(C.mlpackGMMMean(m.mem, C.size_t(i)))
+    output[i] = mat.NewVecDense(d, copyDoubles(ptr, d))
+  }
+  return output
+}
+
+// Covariances() returns a copy of the covariance of each component.
+func (m *gmm) Covariances() []*mat.SymDense {
+  d := m.Dimensionality()
+  output := make([]*mat.SymDense, m.Gaussians())
+  for i := range output {
+    ptr := unsafe.Pointer(C.mlpackGMMCovariance(m.mem, C.size_t(i)))
+    output[i] = mat.NewSymDense(d, copyDoubles(ptr, d * d))
+  }
+  return output
+}
+
+// Responsibilities() returns the posterior probability of each component for
+// each row of points, one row per point and one column per component.
+func (m *gmm) Responsibilities(points *mat.Dense) (*mat.Dense, error) {
+  output, err := m.logJointProbabilities(points)
+  if err != nil {
+    return nil, err
+  }
+
+  r, _ := output.Dims()
+  for i := 0; i < r; i++ {
+    row := output.RawRowView(i)
+    // Normalize in log space to avoid underflow.
+    logSum := floats.LogSumExp(row)
+    for j := range row {
+      row[j] = math.Exp(row[j] - logSum)
+    }
+  }
+  return output, nil
+}
+
+// LogLikelihood() returns the log-likelihood of the rows of points under the
+// model.
+func (m *gmm) LogLikelihood(points *mat.Dense) (float64, error) {
+  logProbs, err := m.logJointProbabilities(points)
+  if err != nil {
+    return 0, err
+  }
+
+  r, _ := logProbs.Dims()
+  logLikelihood := 0.0
+  for i := 0; i < r; i++ {
+    logLikelihood += floats.LogSumExp(logProbs.RawRowView(i))
+  }
+  return logLikelihood, nil
+}
+
+// logJointProbabilities() returns the log of the weight of each component
+// times its density at each row of points.
+func (m *gmm) logJointProbabilities(points *mat.Dense) (*mat.Dense, error) {
+  weights := m.Weights()
+  means := m.Means()
+  covs := m.Covariances()
+
+  components := make([]*distmv.Normal, len(weights))
+  for i := range components {
+    normal, ok := distmv.NewNormal(means[i].RawVector().Data, covs[i], nil)
+    if !ok {
+      return nil, errors.New("covariance is not positive definite")
+    }
+    components[i] = normal
+  }
+
+  r, _ := points.Dims()
+  output := mat.NewDense(r, len(weights), nil)
+  for i := 0; i < r; i++ {
+    row := output.RawRowView(i)
+    for j, normal := range components {
+      row[j] = math.Log(weights[j]) + normal.LogProb(points.RawRowView(i))
+    }
+  }
+  return output, nil
+}
+
+// copyDoubles() copies n doubles from memory owned by mlpack.
+func copyDoubles(ptr unsafe.Pointer, n int) []float64 {
+  output := make([]float64, n)
+  if n == 0 || ptr == nil {
+    return output
+  }
+  copy(output, (*[1<<30 - 1]float64)(ptr)[:n])
+  return output
+}
@@ -54,6 +54,10 @@ func HmmTrainOptions() *HmmTrainOptionalParam {
   
   Optionally, a pre-created HMM model can be used as a guess for the transition
   matrix and emission probabilities; this is specifiable with --model_file.
+  
+  HMMs with known parameters can be built without training with
+  NewDiscreteHMM(), NewGaussianHMM() and NewGMMHMM(), and the parameters of a
+  trained HMM can be read back with its accessor methods.
 
 
   Input parameters:
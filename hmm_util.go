@@ -0,0 +1,246 @@
+package mlpack
+
+/*
+#cgo CFLAGS: -I./capi -Wall
+#cgo LDFLAGS: -L. -lmlpack_go_hmm_train
+#include <capi/hmm_train.h>
+#include <stdlib.h>
+*/
+import "C"
+
+import (
+  "errors"
+  "math"
+  "unsafe"
+
+  "gonum.org/v1/gonum/floats"
+  "gonum.org/v1/gonum/mat"
+)
+
+// The HMM types, in the order of mlpack's HMMType enumeration.
+var hmmTypes = []string{"discrete", "gaussian", "gmm", "diag_gmm"}
+
+// checkHMMParameters() checks that the initial state probabilities and the
+// transition matrix agree on the number of states and are distributions, and
+// returns the transition matrix as a contiguous row-major slice.
+func checkHMMParameters(initial []float64, transition *mat.Dense) ([]float64, error) {
+  s := len(initial)
+  if s == 0 {
+    return nil, errors.New("initial probabilities must not be empty")
+  }
+  if transition == nil {
+    return nil, errors.New("transition matrix must not be nil")
+  }
+  r, c := transition.Dims()
+  if r != s || c != s {
+    return nil, errors.New("transition matrix must be states x states")
+  }
+  if !isDistribution(initial) {
+    return nil, errors.New("initial probabilities must sum to 1")
+  }
+  trans := mat.DenseCopyOf(transition)
+  for i := 0; i < s; i++ {
+    if !isDistribution(trans.RawRowView(i)) {
+      return nil, errors.New("each row of the transition matrix must sum to 1")
+    }
+  }
+  return trans.RawMatrix().Data, nil
+}
+
+// isDistribution() returns whether p sums to 1, with the tolerance used by
+// NewGMM() for the weights.
+func isDistribution(p []float64) bool {
+  return math.Abs(floats.Sum(p) - 1) <= 1e-5
+}
+
+// NewDiscreteHMM() builds a discrete HMM from explicit parameters.  initial
+// holds the probability of starting in each state, transition.At(i, j) is the
+// probability of moving from state i to state j, and emission.At(i, k) is the
+// probability of emitting symbol k in state i.  initial and each row of
+// transition and emission must sum to 1.  The model can be used with
+// HmmViterbi(), HmmLoglik() and HmmGenerate().
+func NewDiscreteHMM(initial []float64, transition *mat.Dense,
+                    emission *mat.Dense) (*hmmModel, error) {
+  trans, err := checkHMMParameters(initial, transition)
+  if err != nil {
+    return nil, err
+  }
+  if emission == nil {
+    return nil, errors.New("emission matrix must not be nil")
+  }
+  r, symbols := emission.Dims()
+  if r != len(initial) {
+    return nil, errors.New("emission matrix must have one row per state")
+  }
+  emissions := mat.DenseCopyOf(emission)
+  for i := 0; i < r; i++ {
+    if !isDistribution(emissions.RawRowView(i)) {
+      return nil, errors.New("each row of the emission matrix must sum to 1")
+    }
+  }
+  emis := emissions.RawMatrix().Data
+
+  var m hmmModel
+  m.mem = C.mlpackNewDiscreteHMM(C.size_t(len(initial)), C.size_t(symbols),
+      (*C.double)(unsafe.Pointer(&initial[0])),
+      (*C.double)(unsafe.Pointer(&trans[0])),
+      (*C.double)(unsafe.Pointer(&emis[0])))
+  return &m, nil
+}
+
+// NewGaussianHMM() builds a Gaussian HMM from explicit parameters; see
+// NewDiscreteHMM() for initial and transition.  State i emits from a Gaussian
+// with mean means[i] and covariance covs[i].
+func NewGaussianHMM(initial []float64, transition *mat.Dense,
+                    means []*mat.VecDense,
+                    covs []*mat.SymDense) (*hmmModel, error) {
+  trans, err := checkHMMParameters(initial, transition)
+  if err != nil {
+    return nil, err
+  }
+  s := len(initial)
+  if len(means) != s || len(covs) != s {
+    return nil, errors.New("there must be one mean and covariance per state")
+  }
+  for i := 0; i < s; i++ {
+    if means[i] == nil || covs[i] == nil {
+      return nil, errors.New("means and covariances must not be nil")
+    }
+  }
+
+  d := means[0].Len()
+  meanData := make([]float64, 0, s * d)
+  covData := make([]float64, 0, s * d * d)
+  for i := 0; i < s; i++ {
+    if means[i].Len() != d || covs[i].SymmetricDim() != d {
+      return nil, errors.New("all means and covariances must have the same " +
+                             "dimensionality")
+    }
+    for j := 0; j < d; j++ {
+      meanData = append(meanData, means[i].AtVec(j))
+    }
+    for j := 0; j < d; j++ {
+      for k := 0; k < d; k++ {
+        covData = append(covData, covs[i].At(j, k))
+      }
+    }
+  }
+
+  var m hmmModel
+  m.mem = C.mlpackNewGaussianHMM(C.size_t(s), C.size_t(d),
+      (*C.double)(unsafe.Pointer(&initial[0])),
+      (*C.double)(unsafe.Pointer(&trans[0])),
+      (*C.double)(unsafe.Pointer(&meanData[0])),
+      (*C.double)(unsafe.Pointer(&covData[0])))
+  return &m, nil
+}
+
+// NewGMMHMM() builds an HMM with GMM emissions from explicit parameters; see
+// NewDiscreteHMM() for initial and transition.  State i emits from
+// emissions[i], which may be built with NewGMM().  If diagonal is true, a
+// diagonal GMM HMM is built, keeping only the diagonal of each covariance.
+func NewGMMHMM(initial []float64, transition *mat.Dense, emissions []*gmm,
+               diagonal bool) (*hmmModel, error) {
+  trans, err := checkHMMParameters(initial, transition)
+  if err != nil {
+    return nil, err
+  }
+  if len(emissions) != len(initial) {
+    return nil, errors.New("there must be one GMM per state")
+  }
+  for _, g := range emissions {
+    if g == nil {
+      return nil, errors.New("emission GMMs must not be nil")
+    }
+  }
+
+  // The GMMs live in C memory, so their pointers may be passed as an array.
+  ptrs := (*[1<<30 - 1]unsafe.Pointer)(C.malloc(
+      C.size_t(len(emissions)) * C.size_t(unsafe.Sizeof(uintptr(0)))))
+  defer C.free(unsafe.Pointer(ptrs))
+  for i, g := range emissions {
+    ptrs[i] = g.mem
+  }
+
+  var m hmmModel
+  m.mem = C.mlpackNewGMMHMM(C.size_t(len(initial)),
+      (*C.double)(unsafe.Pointer(&initial[0])),
+      (*C.double)(unsafe.Pointer(&trans[0])),
+      (*unsafe.Pointer)(unsafe.Pointer(ptrs)), C.bool(diagonal))
+  return &m, nil
+}
+
+// Type() returns the type of the HMM: 'discrete', 'gaussian', 'gmm' or
+// 'diag_gmm'.
+func (m *hmmModel) Type() string {
+  return hmmTypes[int(C.mlpackHMMModelType(m.mem))]
+}
+
+// States() returns the number of hidden states of the HMM.
+func (m *hmmModel) States() int {
+  return int(C.mlpackHMMStates(m.mem))
+}
+
+// Initial() returns a copy of the probability of starting in each state.
+func (m *hmmModel) Initial() []float64 {
+  return copyDoubles(unsafe.Pointer(C.mlpackHMMInitial(m.mem)), m.States())
+}
+
+// Transition() returns a copy of the transition matrix, where At(i, j) is the
+// probability of moving from state i to state j.
+func (m *hmmModel) Transition() *mat.Dense {
+  s := m.States()
+  // mlpack stores the transpose column-major, which is this matrix row-major.
+  ptr := unsafe.Pointer(C.mlpackHMMTransition(m.mem))
+  return mat.NewDense(s, s, copyDoubles(ptr, s * s))
+}
+
+// DiscreteEmission() returns a copy of the emission probabilities of a
+// discrete HMM, one row per state and one column per symbol.
+func (m *hmmModel) DiscreteEmission() (*mat.Dense, error) {
+  if m.Type() != "discrete" {
+    return nil, errors.New("HMM is not discrete")
+  }
+  s := m.States()
+  symbols := int(C.mlpackHMMDiscreteSymbols(m.mem))
+  output := mat.NewDense(s, symbols, nil)
+  for i := 0; i < s; i++ {
+    ptr := unsafe.Pointer(C.mlpackHMMDiscreteEmission(m.mem, C.size_t(i)))
+    output.SetRow(i, copyDoubles(ptr, symbols))
+  }
+  return output, nil
+}
+
+// GaussianEmission() returns copies of the emission means and covariances of
+// a Gaussian HMM, one per state.
+func (m *hmmModel) GaussianEmission() ([]*mat.VecDense, []*mat.SymDense, error) {
+  if m.Type() != "gaussian" {
+    return nil, nil, errors.New("HMM is not Gaussian")
+  }
+  s := m.States()
+  d := int(C.mlpackHMMDimensionality(m.mem))
+  means := make([]*mat.VecDense, s)
+  covs := make([]*mat.SymDense, s)
+  for i := 0; i < s; i++ {
+    meanPtr := unsafe.Pointer(C.mlpackHMMGaussianMean(m.mem, C.size_t(i)))
+    means[i] = mat.NewVecDense(d, copyDoubles(meanPtr, d))
+    covPtr := unsafe.Pointer(C.mlpackHMMGaussianCovariance(m.mem, C.size_t(i)))
+    covs[i] = mat.NewSymDense(d, copyDoubles(covPtr, d * d))
+  }
+  return means, covs, nil
+}
+
+// GMMEmission() returns the emission GMM of each state of a GMM or diagonal
+// GMM HMM.  For a diagonal GMM HMM, the returned GMMs are full covariance
+// copies.  The GMMs of a GMM HMM belong to the HMM and must not be used after
+// it.
+func (m *hmmModel) GMMEmission() ([]*gmm, error) {
+  if t := m.Type(); t != "gmm" && t != "diag_gmm" {
+    return nil, errors.New("HMM does not have GMM emissions")
+  }
+  output := make([]*gmm, m.States())
+  for i := range output {
+    output[i] = &gmm{mem: C.mlpackHMMGMMEmission(m.mem, C.size_t(i))}
+  }
+  return output, nil
+}
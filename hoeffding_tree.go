@@ -94,6 +94,9 @@ func HoeffdingTreeOptions() *HoeffdingTreeOptionalParam {
   param.Test = test_set
   
   _, predictions, class_probs := mlpack.HoeffdingTree(param)
+  
+  To learn from a stream one point at a time, without passing a full training
+  set on every call, use NewHoeffdingTreeStream().
 
 
   Input parameters:
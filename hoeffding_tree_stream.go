@@ -0,0 +1,193 @@
+package mlpack
+
+/*
+#cgo CFLAGS: -I./capi -Wall
+#cgo LDFLAGS: -L. -lmlpack_go_hoeffding_tree
+#include <capi/hoeffding_tree.h>
+#include <stdlib.h>
+*/
+import "C"
+
+import (
+  "errors"
+  "math"
+  "sync"
+  "unsafe"
+
+  "gonum.org/v1/gonum/mat"
+)
+
+// A Hoeffding tree that learns from a stream of points.  Points are passed to
+// mlpack directly, without going through the parameters of the HoeffdingTree()
+// binding, so updates are cheap.  All methods are safe for concurrent use.
+type HoeffdingTreeStream struct {
+  mu sync.Mutex
+  model hoeffdingTreeModel
+  dimensionality int
+  numClasses int
+  // The number of categories of each dimension, or 0 for numeric ones.
+  numCategories []int
+}
+
+/*
+  NewHoeffdingTreeStream() returns an untrained streaming Hoeffding tree.
+  categoricals gives, as in the "Categoricals" of a matrixWithInfo, which
+  dimensions are categorical; numCategories gives the number of categories of
+  each categorical dimension (and is ignored for numeric ones).  The values of
+  a categorical dimension must be integers in [0, numCategories).  The "Bins",
+  "Confidence", "InfoGain", "MaxSamples", "MinSamples", "NumericSplitStrategy"
+  and "ObservationsBeforeBinning" parameters are honored; if param is nil, the
+  defaults of HoeffdingTreeOptions() are used.
+ */
+func NewHoeffdingTreeStream(categoricals []bool, numCategories []int,
+                            numClasses int,
+                            param *HoeffdingTreeOptionalParam) (*HoeffdingTreeStream, error) {
+  d := len(categoricals)
+  if d == 0 || len(numCategories) != d {
+    return nil, errors.New("categoricals and numCategories must have the " +
+                           "same, non-zero length")
+  }
+  if numClasses < 2 {
+    return nil, errors.New("there must be at least two classes")
+  }
+
+  categories := make([]C.size_t, d)
+  counts := make([]int, d)
+  for i := range categories {
+    if categoricals[i] {
+      if numCategories[i] < 1 {
+        return nil, errors.New("categorical dimensions need categories")
+      }
+      categories[i] = C.size_t(numCategories[i])
+      counts[i] = numCategories[i]
+    }
+  }
+
+  if param == nil {
+    param = HoeffdingTreeOptions()
+  }
+  strategy := C.CString(param.NumericSplitStrategy)
+  defer C.free(unsafe.Pointer(strategy))
+
+  s := &HoeffdingTreeStream{
+    dimensionality: d,
+    numClasses: numClasses,
+    numCategories: counts,
+  }
+  s.model.mem = C.mlpackNewHoeffdingTreeModel(
+      (*C.bool)(unsafe.Pointer(&categoricals[0])), &categories[0],
+      C.size_t(d), C.size_t(numClasses), C.bool(param.InfoGain), strategy,
+      C.double(param.Confidence), C.size_t(param.MaxSamples),
+      C.size_t(param.MinSamples), C.size_t(param.Bins),
+      C.size_t(param.ObservationsBeforeBinning))
+  return s, nil
+}
+
+// HoeffdingTreeStreamFrom() returns a streaming Hoeffding tree that continues
+// learning from a model trained with HoeffdingTree().
+func HoeffdingTreeStreamFrom(model *hoeffdingTreeModel) *HoeffdingTreeStream {
+  d := int(C.mlpackHoeffdingTreeDimensionality(model.mem))
+  counts := make([]int, d)
+  for i := range counts {
+    counts[i] = int(C.mlpackHoeffdingTreeNumCategories(model.mem, C.size_t(i)))
+  }
+  return &HoeffdingTreeStream{
+    model: *model,
+    dimensionality: d,
+    numClasses: int(C.mlpackHoeffdingTreeNumClasses(model.mem)),
+    numCategories: counts,
+  }
+}
+
+// Update() trains the tree on a single point and its label.
+func (s *HoeffdingTreeStream) Update(point []float64, label int) error {
+  if err := s.check(point, label); err != nil {
+    return err
+  }
+
+  s.mu.Lock()
+  defer s.mu.Unlock()
+  C.mlpackHoeffdingTreeTrain(s.model.mem,
+      (*C.double)(unsafe.Pointer(&point[0])), C.size_t(label))
+  return nil
+}
+
+// UpdateBatch() trains the tree on each row of points and its label, in order.
+func (s *HoeffdingTreeStream) UpdateBatch(points *mat.Dense,
+                                          labels []int) error {
+  r, c := points.Dims()
+  if c != s.dimensionality {
+    return errors.New("points have the wrong dimensionality")
+  }
+  if len(labels) != r {
+    return errors.New("there must be one label per point")
+  }
+  data := mat.DenseCopyOf(points).RawMatrix().Data
+  cLabels := make([]C.size_t, r)
+  for i := 0; i < r; i++ {
+    if err := s.check(data[i * s.dimensionality : (i + 1) * s.dimensionality],
+                      labels[i]); err != nil {
+      return err
+    }
+    cLabels[i] = C.size_t(labels[i])
+  }
+
+  s.mu.Lock()
+  defer s.mu.Unlock()
+  C.mlpackHoeffdingTreeTrainBatch(s.model.mem,
+      (*C.double)(unsafe.Pointer(&data[0])), &cLabels[0], C.size_t(r))
+  return nil
+}
+
+// Predict() returns the predicted class of a point.
+func (s *HoeffdingTreeStream) Predict(point []float64) (int, error) {
+  prediction, _, err := s.classify(point)
+  return prediction, err
+}
+
+// PredictProba() returns the predicted class of a point along with its
+// probability.  As for the "probabilities" output of HoeffdingTree(), only the
+// probability of the predicted class is available: mlpack's Hoeffding trees do
+// not give the probabilities of the other classes.
+func (s *HoeffdingTreeStream) PredictProba(
+    point []float64) (int, float64, error) {
+  return s.classify(point)
+}
+
+// Model() returns the underlying model, for use with HoeffdingTree().  The
+// model is shared with the stream, so it must not be used while the stream is
+// being updated.
+func (s *HoeffdingTreeStream) Model() hoeffdingTreeModel {
+  return s.model
+}
+
+func (s *HoeffdingTreeStream) classify(point []float64) (int, float64, error) {
+  if err := s.check(point, 0); err != nil {
+    return 0, 0, err
+  }
+
+  var probability C.double
+  s.mu.Lock()
+  defer s.mu.Unlock()
+  prediction := C.mlpackHoeffdingTreeClassify(s.model.mem,
+      (*C.double)(unsafe.Pointer(&point[0])), &probability)
+  return int(prediction), float64(probability), nil
+}
+
+// check() validates the dimensionality and categorical values of a point and
+// its label.
+func (s *HoeffdingTreeStream) check(point []float64, label int) error {
+  if len(point) != s.dimensionality {
+    return errors.New("point has the wrong dimensionality")
+  }
+  if label < 0 || label >= s.numClasses {
+    return errors.New("label out of range")
+  }
+  for i, n := range s.numCategories {
+    if n > 0 && (point[i] < 0 || point[i] >= float64(n) ||
+                 point[i] != math.Trunc(point[i])) {
+      return errors.New("categorical value out of range")
+    }
+  }
+  return nil
+}
@@ -61,6 +61,9 @@ func ImageConverterOptions() *ImageConverterOptionalParam {
   param.Save = true
   
   _ := mlpack.ImageConverter(X, param)
+  
+  Images that are already in memory can be converted to and from the same
+  matrix layout with ImagesToMatrix() and MatrixToImages().
 
 
   Input parameters:
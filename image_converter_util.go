@@ -0,0 +1,156 @@
+package mlpack
+
+import (
+  "errors"
+  "image"
+  "image/color"
+  "math"
+
+  "gonum.org/v1/gonum/mat"
+)
+
+// ImagesToMatrix() converts in-memory images to the matrix layout used by
+// ImageConverter(), without writing them to disk.  Each image becomes one row
+// holding its pixels in row-major order with interleaved channels and values
+// in [0, 255].  The "Width", "Height" and "Channels" parameters are honored;
+// if they are 0, they are detected from the first image.  Every image must
+// have the given width and height.  If param is nil, everything is detected.
+func ImagesToMatrix(images []image.Image,
+                    param *ImageConverterOptionalParam) (*mat.Dense, error) {
+  if len(images) == 0 {
+    return nil, errors.New("no images given")
+  }
+
+  p := ImageConverterOptions()
+  if param != nil {
+    *p = *param
+  }
+  width, height, channels := p.Width, p.Height, p.Channels
+  bounds := images[0].Bounds()
+  if width == 0 {
+    width = bounds.Dx()
+  }
+  if height == 0 {
+    height = bounds.Dy()
+  }
+  if channels == 0 {
+    channels = imageChannels(images[0])
+  }
+  if channels < 1 || channels > 4 {
+    return nil, errors.New("channels must be between 1 and 4")
+  }
+
+  output := mat.NewDense(len(images), width * height * channels, nil)
+  for i, img := range images {
+    b := img.Bounds()
+    if b.Dx() != width || b.Dy() != height {
+      return nil, errors.New("image dimensions do not match the given width " +
+                             "and height")
+    }
+
+    row := output.RawRowView(i)
+    k := 0
+    for y := b.Min.Y; y < b.Max.Y; y++ {
+      for x := b.Min.X; x < b.Max.X; x++ {
+        c := color.NRGBAModel.Convert(img.At(x, y)).(color.NRGBA)
+        switch channels {
+        case 1, 2:
+          // The luminance is computed from the unpremultiplied color with the
+          // weights used when an image file is loaded with fewer channels.
+          row[k] = float64((77 * int(c.R) + 150 * int(c.G) +
+                            29 * int(c.B)) >> 8)
+          if channels == 2 {
+            row[k + 1] = float64(c.A)
+          }
+        default:
+          row[k] = float64(c.R)
+          row[k + 1] = float64(c.G)
+          row[k + 2] = float64(c.B)
+          if channels == 4 {
+            row[k + 3] = float64(c.A)
+          }
+        }
+        k += channels
+      }
+    }
+  }
+  return output, nil
+}
+
+// MatrixToImages() is the reverse of ImagesToMatrix(): it converts each row of
+// the given matrix, as returned by ImageConverter(), back into an image.  The
+// "Width", "Height" and "Channels" parameters must be given.  Values are
+// rounded and clamped to [0, 255].  Images with 1 channel are returned as
+// *image.Gray and all others as *image.NRGBA.
+func MatrixToImages(m *mat.Dense,
+                    param *ImageConverterOptionalParam) ([]image.Image, error) {
+  p := ImageConverterOptions()
+  if param != nil {
+    *p = *param
+  }
+  width, height, channels := p.Width, p.Height, p.Channels
+  if width == 0 || height == 0 || channels == 0 {
+    return nil, errors.New("width, height and channels must be given")
+  }
+  if channels > 4 {
+    return nil, errors.New("channels must be between 1 and 4")
+  }
+
+  r, c := m.Dims()
+  if c != width * height * channels {
+    return nil, errors.New("matrix dimensions do not match the given width, " +
+                           "height and channels")
+  }
+
+  output := make([]image.Image, r)
+  row := make([]float64, c)
+  for i := 0; i < r; i++ {
+    mat.Row(row, i, m)
+    rect := image.Rect(0, 0, width, height)
+
+    if channels == 1 {
+      img := image.NewGray(rect)
+      for k := range img.Pix {
+        img.Pix[k] = toPixel(row[k])
+      }
+      output[i] = img
+      continue
+    }
+
+    img := image.NewNRGBA(rect)
+    for p := 0; p < width * height; p++ {
+      v := row[p * channels : (p + 1) * channels]
+      px := img.Pix[p * 4 : (p + 1) * 4]
+      switch channels {
+      case 2:
+        px[0], px[1], px[2], px[3] =
+            toPixel(v[0]), toPixel(v[0]), toPixel(v[0]), toPixel(v[1])
+      case 3:
+        px[0], px[1], px[2], px[3] =
+            toPixel(v[0]), toPixel(v[1]), toPixel(v[2]), 255
+      default:
+        px[0], px[1], px[2], px[3] =
+            toPixel(v[0]), toPixel(v[1]), toPixel(v[2]), toPixel(v[3])
+      }
+    }
+    output[i] = img
+  }
+  return output, nil
+}
+
+// imageChannels() detects the number of channels of an image from its color
+// model, in the same way they would be detected when loading it from a file.
+func imageChannels(img image.Image) int {
+  switch img.ColorModel() {
+  case color.GrayModel, color.Gray16Model:
+    return 1
+  case color.RGBAModel, color.RGBA64Model, color.NRGBAModel,
+       color.NRGBA64Model:
+    return 4
+  }
+  return 3
+}
+
+func toPixel(v float64) uint8 {
+  return uint8(math.Max(0, math.Min(255, math.Round(v))))
+}
@@ -85,6 +85,9 @@ func KernelPcaOptions() *KernelPcaOptionalParam {
   as basis to reconstruct the kernel matrix; to specify the sampling scheme, the
   "Sampling" parameter is used.  The sampling scheme for the Nystroem method can
   be chosen from the following list: 'kmeans', 'random', 'ordered'.
+  
+  To embed new points into the same space after training, use KernelPcaFit(),
+  which returns a model with a Transform() method.
 
 
   Input parameters:
@@ -116,6 +119,25 @@ func KernelPcaOptions() *KernelPcaOptionalParam {
 
  */
 func KernelPca(input *mat.Dense, kernel string, param *KernelPcaOptionalParam) (*mat.Dense) {
+  runKernelPca(input, kernel, param, false)
+
+  // Initialize result variable and get output.
+  var outputPtr mlpackArma
+  output := outputPtr.armaToGonumMat("output")
+
+  // Clear settings.
+  clearSettings()
+
+  // Return output(s).
+  return output
+}
+
+// runKernelPca runs kernel PCA on the input with the given kernel.  If model is
+// set, the reference points, projection and kernel means needed to embed new
+// points are also requested, as KernelPcaFit() does.  The outputs are left for
+// the caller to read before it clears the settings.
+func runKernelPca(input *mat.Dense, kernel string,
+                  param *KernelPcaOptionalParam, model bool) {
   resetTimers()
   enableTimers()
   disableBacktrace()
@@ -187,17 +209,13 @@ func KernelPca(input *mat.Dense, kernel string, param *KernelPcaOptionalParam) (
 
   // Mark all output options as passed.
   setPassed("output")
+  if model {
+    setPassed("kernel_mean")
+    setPassed("kernel_row_mean")
+    setPassed("projection")
+    setPassed("reference")
+  }
 
   // Call the mlpack program.
   C.mlpackKernelPca()
-
-  // Initialize result variable and get output.
-  var outputPtr mlpackArma
-  output := outputPtr.armaToGonumMat("output")
-
-  // Clear settings.
-  clearSettings()
-
-  // Return output(s).
-  return output
 }
@@ -0,0 +1,183 @@
+package mlpack
+
+import (
+  "errors"
+  "math"
+
+  "gonum.org/v1/gonum/floats"
+  "gonum.org/v1/gonum/mat"
+)
+
+// A fitted kernel principal components analysis model.  New points are
+// embedded by evaluating the kernel between them and each row of Reference
+// (the training points, or the sampled basis points if the Nystroem method was
+// used), centering the kernel values in the same way as during training, and
+// multiplying by Projection, which has one row per retained component.
+type kernelPcaModel struct {
+  Kernel string
+  Bandwidth float64
+  Degree float64
+  KernelScale float64
+  Offset float64
+  Reference *mat.Dense
+  Projection *mat.Dense
+  KernelRowMean []float64
+  KernelMean float64
+  OutputMean []float64
+}
+
+// KernelPcaFit() runs KernelPca() on the input and returns the fitted model
+// along with the transformed input.  All kernels accepted by KernelPca() are
+// supported, with or without the Nystroem method.  If param is nil, the
+// defaults of KernelPcaOptions() are used.
+func KernelPcaFit(input *mat.Dense, kernel string,
+                  param *KernelPcaOptionalParam) (*kernelPcaModel, *mat.Dense) {
+  if param == nil {
+    param = KernelPcaOptions()
+  }
+  runKernelPca(input, kernel, param, true)
+
+  // Initialize result variable and get output.
+  var outputPtr mlpackArma
+  output := outputPtr.armaToGonumMat("output")
+  var referencePtr mlpackArma
+  reference := referencePtr.armaToGonumMat("reference")
+  var projectionPtr mlpackArma
+  projection := projectionPtr.armaToGonumMat("projection")
+  var kernelRowMeanPtr mlpackArma
+  kernelRowMean := kernelRowMeanPtr.armaToGonumCol("kernel_row_mean")
+  kernelMean := getParamDouble("kernel_mean")
+
+  // Clear settings.
+  clearSettings()
+
+  m := &kernelPcaModel{
+    Kernel: kernel,
+    Bandwidth: param.Bandwidth,
+    Degree: param.Degree,
+    KernelScale: param.KernelScale,
+    Offset: param.Offset,
+    Reference: reference,
+    Projection: projection,
+    KernelRowMean: kernelRowMean.RawRowView(0),
+    KernelMean: kernelMean,
+  }
+
+  // The training output was centered by the program, so the same shift must
+  // be applied to new points.
+  if param.Center {
+    uncentered := m.project(input)
+    r, c := uncentered.Dims()
+    m.OutputMean = make([]float64, c)
+    for j := 0; j < c; j++ {
+      for i := 0; i < r; i++ {
+        m.OutputMean[j] += uncentered.At(i, j)
+      }
+      m.OutputMean[j] /= float64(r)
+    }
+  }
+  return m, output
+}
+
+// Transform() embeds each row of points into the space learned by the model.
+// An error is returned if points does not have the dimensionality of the
+// training data.
+func (m *kernelPcaModel) Transform(points *mat.Dense) (*mat.Dense, error) {
+  if _, err := m.kernelFunc(); err != nil {
+    return nil, err
+  }
+  if m.Reference == nil || m.Projection == nil {
+    return nil, errors.New("model has not been fitted")
+  }
+  _, c := m.Reference.Dims()
+  if _, d := points.Dims(); d != c {
+    return nil, errors.New("points must have the same number of columns as " +
+                           "the training data")
+  }
+  output := m.project(points)
+  if m.OutputMean != nil {
+    output.Apply(func(i, j int, v float64) float64 {
+      return v - m.OutputMean[j]
+    }, output)
+  }
+  return output, nil
+}
+
+// project() returns the uncentered embedding of each row of points.
+func (m *kernelPcaModel) project(points *mat.Dense) *mat.Dense {
+  kernel, _ := m.kernelFunc()
+  r, _ := points.Dims()
+  n, _ := m.Reference.Dims()
+  k, _ := m.Projection.Dims()
+
+  centered := mat.NewDense(r, n, nil)
+  for i := 0; i < r; i++ {
+    row := centered.RawRowView(i)
+    for j := 0; j < n; j++ {
+      row[j] = kernel(points.RawRowView(i), m.Reference.RawRowView(j))
+    }
+    mean := floats.Sum(row) / float64(n)
+    for j := range row {
+      row[j] += m.KernelMean - m.KernelRowMean[j] - mean
+    }
+  }
+
+  output := mat.NewDense(r, k, nil)
+  output.Mul(centered, m.Projection.T())
+  return output
+}
+
+// kernelFunc() returns the kernel of the model, matching the kernels of
+// KernelPca().
+func (m *kernelPcaModel) kernelFunc() (func(x, y []float64) float64, error) {
+  switch m.Kernel {
+  case "linear":
+    return floats.Dot, nil
+  case "gaussian":
+    return func(x, y []float64) float64 {
+      d := floats.Distance(x, y, 2)
+      return math.Exp(-(d * d) / (2 * m.Bandwidth * m.Bandwidth))
+    }, nil
+  case "polynomial":
+    return func(x, y []float64) float64 {
+      return math.Pow(floats.Dot(x, y) + m.Offset, m.Degree)
+    }, nil
+  case "hyptan":
+    return func(x, y []float64) float64 {
+      return math.Tanh(m.KernelScale * floats.Dot(x, y) + m.Offset)
+    }, nil
+  case "laplacian":
+    return func(x, y []float64) float64 {
+      return math.Exp(-floats.Distance(x, y, 2) / m.Bandwidth)
+    }, nil
+  case "epanechnikov":
+    return func(x, y []float64) float64 {
+      d := floats.Distance(x, y, 2)
+      return math.Max(0, 1 - (d * d) / (m.Bandwidth * m.Bandwidth))
+    }, nil
+  case "cosine":
+    // This matches mlpack's CosineDistance::Evaluate(), which returns the
+    // cosine similarity.
+    return func(x, y []float64) float64 {
+      nx, ny := floats.Norm(x, 2), floats.Norm(y, 2)
+      if nx == 0 || ny == 0 {
+        return 0
+      }
+      return floats.Dot(x, y) / (nx * ny)
+    }, nil
+  }
+  return nil, errors.New("unknown kernel '" + m.Kernel + "'")
+}
+
+// kernelPcaModelData is the kernelPcaModel type given to gobMarshal().
+type kernelPcaModelData kernelPcaModel
+
+// MarshalBinary() serializes the model.
+func (m *kernelPcaModel) MarshalBinary() ([]byte, error) {
+  return gobMarshal((*kernelPcaModelData)(m))
+}
+
+// UnmarshalBinary() deserializes a model serialized with MarshalBinary().
+func (m *kernelPcaModel) UnmarshalBinary(data []byte) error {
+  return gobUnmarshal(data, (*kernelPcaModelData)(m))
+}
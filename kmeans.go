@@ -100,6 +100,9 @@ func KmeansOptions() *KmeansOptionalParam {
   param.MaxIterations = 500
   
   final, _ := mlpack.Kmeans(data, 10, param)
+  
+  To keep a model that can assign new points to the learned clusters, use
+  KmeansFit() instead.
 
 
   Input parameters:
@@ -0,0 +1,160 @@
+package mlpack
+
+import (
+  "errors"
+  "math"
+
+  "gonum.org/v1/gonum/mat"
+)
+
+// The number of clusters from which Assign() switches from a brute-force
+// search over the centroids to mlpack's tree-based nearest neighbor search.
+const kmeansTreeThreshold = 64
+
+// A trained k-means model that can assign unseen points to the learned
+// clusters.  Each row of Centroids is the centroid of one cluster.
+type kmeansModel struct {
+  Centroids *mat.Dense
+}
+
+// NewKmeansModel() returns a k-means model with the given centroids, one per
+// row.
+func NewKmeansModel(centroids *mat.Dense) *kmeansModel {
+  return &kmeansModel{Centroids: centroids}
+}
+
+// KmeansFit() runs Kmeans() on the input and returns the trained model along
+// with the cluster assignment of each input point.  If the "InitialCentroids"
+// parameter is given, the clustering is warm-started from them.  If param is
+// nil, the defaults of KmeansOptions() are used.
+func KmeansFit(clusters int, input *mat.Dense,
+               param *KmeansOptionalParam) (*kmeansModel, []int) {
+  m := &kmeansModel{}
+  assignments := m.fit(clusters, input, param)
+  return m, assignments
+}
+
+// Fit() continues training the model on the given input, warm-starting from
+// the current centroids, and returns the cluster assignment of each input
+// point.  param may be nil, as for KmeansFit().
+func (m *kmeansModel) Fit(input *mat.Dense, param *KmeansOptionalParam) []int {
+  p := *KmeansOptions()
+  if param != nil {
+    p = *param
+  }
+  if m.Centroids != nil {
+    p.InitialCentroids = m.Centroids
+  }
+  return m.fit(0, input, &p)
+}
+
+func (m *kmeansModel) fit(clusters int, input *mat.Dense,
+                          param *KmeansOptionalParam) []int {
+  p := *KmeansOptions()
+  if param != nil {
+    p = *param
+  }
+  p.InPlace = false
+  p.LabelsOnly = true
+  centroid, output := Kmeans(clusters, input, &p)
+  m.Centroids = centroid
+
+  r, _ := output.Dims()
+  assignments := make([]int, r)
+  for i := range assignments {
+    assignments[i] = int(output.At(i, 0))
+  }
+  return assignments
+}
+
+// Assign() returns the index of the nearest centroid for each row of points.
+// For models with many clusters, mlpack's tree-based nearest neighbor search is
+// used instead of a brute-force search.
+func (m *kmeansModel) Assign(points *mat.Dense) ([]int, error) {
+  if err := m.check(points); err != nil {
+    return nil, err
+  }
+  k, _ := m.Centroids.Dims()
+  if k >= kmeansTreeThreshold {
+    param := KnnOptions()
+    param.K = 1
+    param.Reference = m.Centroids
+    param.Query = points
+    _, neighbors, _ := Knn(param)
+
+    r, _ := neighbors.Dims()
+    assignments := make([]int, r)
+    for i := range assignments {
+      assignments[i] = int(neighbors.At(i, 0))
+    }
+    return assignments, nil
+  }
+
+  distances, _ := m.Transform(points)
+  r, _ := distances.Dims()
+  assignments := make([]int, r)
+  for i := range assignments {
+    best := math.Inf(1)
+    for j := 0; j < k; j++ {
+      if d := distances.At(i, j); d < best {
+        best = d
+        assignments[i] = j
+      }
+    }
+  }
+  return assignments, nil
+}
+
+// Transform() returns the Euclidean distance from each row of points to each
+// centroid, one row per point and one column per cluster.
+func (m *kmeansModel) Transform(points *mat.Dense) (*mat.Dense, error) {
+  if err := m.check(points); err != nil {
+    return nil, err
+  }
+  r, d := points.Dims()
+  k, _ := m.Centroids.Dims()
+
+  output := mat.NewDense(r, k, nil)
+  for i := 0; i < r; i++ {
+    point := points.RawRowView(i)
+    for j := 0; j < k; j++ {
+      centroid := m.Centroids.RawRowView(j)
+      sum := 0.0
+      for l := 0; l < d; l++ {
+        diff := point[l] - centroid[l]
+        sum += diff * diff
+      }
+      output.Set(i, j, math.Sqrt(sum))
+    }
+  }
+  return output, nil
+}
+
+// check() returns an error if the model has no centroids or points does not
+// have their dimensionality.
+func (m *kmeansModel) check(points *mat.Dense) error {
+  if m.Centroids == nil {
+    return errors.New("model has no centroids")
+  }
+  _, c := m.Centroids.Dims()
+  if _, d := points.Dims(); d != c {
+    return errors.New("points must have the same number of columns as the " +
+                      "centroids")
+  }
+  return nil
+}
+
+// MarshalBinary() serializes the model.
+func (m *kmeansModel) MarshalBinary() ([]byte, error) {
+  return m.Centroids.MarshalBinary()
+}
+
+// UnmarshalBinary() deserializes a model serialized with MarshalBinary().
+func (m *kmeansModel) UnmarshalBinary(data []byte) error {
+  var centroids mat.Dense
+  if err := centroids.UnmarshalBinary(data); err != nil {
+    return err
+  }
+  m.Centroids = &centroids
+  return nil
+}
@@ -70,6 +70,9 @@ func KnnOptions() *KnnOptionalParam {
   j'th nearest neighbor from the point in the query set with index i.  Row j and
   column i in the distances output matrix corresponds to the distance between
   those two points.
+  
+  To score points as outliers from their nearest neighbor distances, including
+  the Local Outlier Factor, use NewOutlierDetector().
 
 
   Input parameters:
@@ -0,0 +1,169 @@
+package mlpack
+
+import (
+  "errors"
+  "math"
+
+  "gonum.org/v1/gonum/mat"
+)
+
+// An outlier detector that scores points by their distances to their k nearest
+// neighbors in a reference set, found with Knn().
+type OutlierDetector struct {
+  Model knnModel
+  K int
+  param KnnOptionalParam
+  // The k-distance and the local reachability density of each reference
+  // point, and the scores of the reference points themselves.
+  kDistance []float64
+  lrd []float64
+  training map[string][]float64
+}
+
+/*
+  NewOutlierDetector() finds the k nearest neighbors of every point in
+  reference with Knn() and prepares the detector to score points against it.
+  The "Algorithm", "Epsilon", "LeafSize", "RandomBasis", "Rho", "Seed", "Tau"
+  and "TreeType" parameters of param are passed on to Knn(), so any tree type
+  supported by the neighbor search may be used; the other parameters are
+  ignored.  If param is nil, the defaults of KnnOptions() are used.
+
+  The following scores are available through Scores(), where higher scores
+  indicate more anomalous points:
+   - 'lof' -- Local Outlier Factor: the average local reachability density of
+  the neighbors of a point divided by its own.  Scores near 1 are inliers.
+   - 'kdist' -- Distance to the k-th nearest neighbor.
+   - 'mean_kdist' -- Average distance to the k nearest neighbors.
+
+  For example, to compute the LOF of each point in data using its 20 nearest
+  neighbors found with a ball tree, one could call
+
+  // Initialize optional parameters for Knn().
+  param := mlpack.KnnOptions()
+  param.TreeType = "ball"
+
+  detector, _ := mlpack.NewOutlierDetector(data, 20, param)
+  scores, _ := detector.Scores(nil, "lof")
+
+ */
+func NewOutlierDetector(reference *mat.Dense, k int,
+                        param *KnnOptionalParam) (*OutlierDetector, error) {
+  n, _ := reference.Dims()
+  if k < 1 || k >= n {
+    return nil, errors.New("k must be between 1 and the number of points " +
+                           "minus 1")
+  }
+
+  d := &OutlierDetector{K: k, param: *KnnOptions()}
+  if param != nil {
+    d.param = *param
+  }
+  d.param.InputModel = nil
+  d.param.Query = nil
+  d.param.TrueDistances = nil
+  d.param.TrueNeighbors = nil
+
+  // Without a query set, each point is excluded from its own neighbors.
+  p := d.param
+  p.K = k
+  p.Reference = reference
+  distances, neighbors, model := Knn(&p)
+  d.Model = model
+  d.param.Reference = nil
+
+  d.kDistance = make([]float64, n)
+  for i := range d.kDistance {
+    d.kDistance[i] = distances.At(i, k - 1)
+  }
+  d.lrd = d.reachabilityDensities(distances, neighbors)
+  d.training = map[string][]float64{
+    "lof": d.lof(d.lrd, neighbors),
+    "kdist": d.kDistance,
+    "mean_kdist": meanRows(distances),
+  }
+  return d, nil
+}
+
+/*
+  Scores() returns the given outlier score, 'lof', 'kdist' or 'mean_kdist', for
+  each row of points against the reference set of the detector.  If points is
+  nil, the scores of the reference points themselves are returned; in that case
+  a point is never counted as its own neighbor.
+
+ */
+func (d *OutlierDetector) Scores(points *mat.Dense,
+                                 method string) ([]float64, error) {
+  if method != "lof" && method != "kdist" && method != "mean_kdist" {
+    return nil, errors.New("method must be 'lof', 'kdist' or 'mean_kdist'")
+  }
+  if points == nil {
+    output := make([]float64, len(d.training[method]))
+    copy(output, d.training[method])
+    return output, nil
+  }
+
+  // The tree of the model is reused; only the search settings are passed.
+  p := KnnOptions()
+  p.Algorithm = d.param.Algorithm
+  p.Epsilon = d.param.Epsilon
+  p.InputModel = &d.Model
+  p.K = d.K
+  p.Query = points
+  distances, neighbors, _ := Knn(p)
+
+  switch method {
+  case "kdist":
+    r, _ := distances.Dims()
+    output := make([]float64, r)
+    for i := range output {
+      output[i] = distances.At(i, d.K - 1)
+    }
+    return output, nil
+  case "mean_kdist":
+    return meanRows(distances), nil
+  default:
+    return d.lof(d.reachabilityDensities(distances, neighbors), neighbors), nil
+  }
+}
+
+// reachabilityDensities() returns the local reachability density of each query
+// point with the given neighbors in the reference set.  As in scikit-learn, a
+// small constant keeps the density of duplicated points finite.
+func (d *OutlierDetector) reachabilityDensities(
+    distances *mat.Dense, neighbors *mat.Dense) []float64 {
+  r, _ := distances.Dims()
+  output := make([]float64, r)
+  for i := range output {
+    sum := 0.0
+    for j := 0; j < d.K; j++ {
+      neighbor := int(neighbors.At(i, j))
+      sum += math.Max(d.kDistance[neighbor], distances.At(i, j))
+    }
+    output[i] = 1 / (sum / float64(d.K) + 1e-10)
+  }
+  return output
+}
+
+// lof() returns the local outlier factor of each query point from its local
+// reachability density and those of its neighbors in the reference set.
+func (d *OutlierDetector) lof(lrd []float64, neighbors *mat.Dense) []float64 {
+  output := make([]float64, len(lrd))
+  for i := range output {
+    sum := 0.0
+    for j := 0; j < d.K; j++ {
+      sum += d.lrd[int(neighbors.At(i, j))]
+    }
+    output[i] = sum / float64(d.K) / lrd[i]
+  }
+  return output
+}
+
+// meanRows() returns the mean of each row of m.
+func meanRows(m *mat.Dense) []float64 {
+  r, c := m.Dims()
+  output := make([]float64, r)
+  for i := range output {
+    output[i] = mat.Sum(m.RowView(i)) / float64(c)
+  }
+  return output
+}
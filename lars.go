@@ -94,6 +94,10 @@ func LarsOptions() *LarsOptionalParam {
   param.Test = test
   
   _, test_predictions := mlpack.Lars(param)
+  
+  The whole regularization path of a trained model, with the coefficients,
+  lambdas and active set of each step, is available through its Path() method.
+  To choose "Lambda1" by cross-validation along the path, use LarsCV().
 
 
   Input parameters:
@@ -0,0 +1,268 @@
+package mlpack
+
+/*
+#cgo CFLAGS: -I./capi -Wall
+#cgo LDFLAGS: -L. -lmlpack_go_lars
+#include <capi/lars.h>
+#include <stdlib.h>
+*/
+import "C"
+
+import (
+  "errors"
+  "math"
+  "unsafe"
+
+  "gonum.org/v1/gonum/mat"
+)
+
+// The regularization path computed by LARS.  Row i of each matrix describes
+// step i of the path, from the all-zero solution at the largest lambda down to
+// the final solution of the model.
+type LarsPath struct {
+  // The coefficient of each dimension, one column per dimension.
+  Coefficients *mat.Dense
+  // The l1 penalty at which each step is reached, as a single column.
+  Lambdas *mat.Dense
+  // The active dimensions in the order they entered the model, padded with
+  // -1, one column per dimension.
+  ActiveSets *mat.Dense
+}
+
+// The l1 penalty LarsCV() computes its paths down to.  mlpack only computes
+// the LASSO path when "Lambda1" is positive, so a tiny penalty is used instead
+// of 0.
+const larsPathLambda1 = 1e-10
+
+// The result of LarsCV().
+type LarsCVResult struct {
+  Model lars
+  Lambda float64
+  // The candidate lambdas and the mean squared validation error of each.
+  Lambdas []float64
+  Errors []float64
+}
+
+/*
+  Path() returns the regularization path the model was trained along.  The
+  path ends at the "Lambda1" the model was trained with.  mlpack computes the
+  LASSO path, along which dimensions may also leave the model, only for a
+  positive "Lambda1"; with a "Lambda1" of 0 it computes the LAR path instead,
+  down to the least squares solution.  A tiny positive "Lambda1" such as 1e-10
+  gives the full LASSO path.  If the model has no path, for example because it
+  was never trained, the matrices of the returned path are nil.
+
+ */
+func (m *lars) Path() *LarsPath {
+  steps := int(C.mlpackLARSPathLength(m.mem))
+  d := int(C.mlpackLARSBetaSize(m.mem))
+  if steps == 0 || d == 0 {
+    return &LarsPath{}
+  }
+
+  path := &LarsPath{
+    Coefficients: mat.NewDense(steps, d, nil),
+    Lambdas: mat.NewDense(steps, 1, copyDoubles(
+        unsafe.Pointer(C.mlpackLARSLambdaPath(m.mem)), steps)),
+    ActiveSets: mat.NewDense(steps, d, nil),
+  }
+
+  var active []int
+  for i := 0; i < steps; i++ {
+    ptr := unsafe.Pointer(C.mlpackLARSBetaPath(m.mem, C.size_t(i)))
+    beta := copyDoubles(ptr, d)
+    path.Coefficients.SetRow(i, beta)
+
+    // Drop the dimensions that left the model, in the LASSO case, and append
+    // the ones that entered it, keeping the order of entry.
+    next := active[:0]
+    inActive := make(map[int]bool, len(active))
+    for _, j := range active {
+      if beta[j] != 0 {
+        next = append(next, j)
+        inActive[j] = true
+      }
+    }
+    for j, b := range beta {
+      if b != 0 && !inActive[j] {
+        next = append(next, j)
+      }
+    }
+    active = next
+
+    for j := 0; j < d; j++ {
+      if j < len(active) {
+        path.ActiveSets.Set(i, j, float64(active[j]))
+      } else {
+        path.ActiveSets.Set(i, j, -1)
+      }
+    }
+  }
+  return path
+}
+
+// Steps() returns the number of steps of the path.
+func (p *LarsPath) Steps() int {
+  if p.Coefficients == nil {
+    return 0
+  }
+  steps, _ := p.Coefficients.Dims()
+  return steps
+}
+
+// At() returns the coefficients of the path at the given l1 penalty.  Between
+// steps, the coefficients are interpolated linearly, which is exact for the
+// LASSO path.  An empty path gives nil.
+func (p *LarsPath) At(lambda float64) []float64 {
+  if p.Steps() == 0 {
+    return nil
+  }
+  steps, d := p.Coefficients.Dims()
+  last := steps - 1
+  if lambda >= p.Lambdas.At(0, 0) {
+    return mat.Row(nil, 0, p.Coefficients)
+  }
+  if lambda <= p.Lambdas.At(last, 0) {
+    return mat.Row(nil, last, p.Coefficients)
+  }
+
+  k := 0
+  for p.Lambdas.At(k + 1, 0) > lambda {
+    k++
+  }
+  hi, lo := p.Lambdas.At(k, 0), p.Lambdas.At(k + 1, 0)
+  t := (hi - lambda) / (hi - lo)
+  output := make([]float64, d)
+  for j := range output {
+    output[j] = (1 - t) * p.Coefficients.At(k, j) +
+        t * p.Coefficients.At(k + 1, j)
+  }
+  return output
+}
+
+/*
+  LarsCV() chooses the l1 penalty of a LARS model by k-fold cross-validation
+  and trains the final model on all the data with it.  The candidate penalties
+  are the lambdas of the full path on all the data.  For each fold, the full
+  path is computed on the remaining data and evaluated at every candidate by
+  interpolation, so only one LARS run per fold is needed.  All paths are LASSO
+  paths, like the final model, and end at a tiny positive penalty.  As the
+  penalty of mlpack's LARS is not normalized by the number of points, each
+  candidate is scaled by the fraction of the points the fold is trained on.
+  Point i is assigned to fold i % folds.
+  
+  The "Input" and "Responses" parameters give the data; "Lambda2" and
+  "UseCholesky" are used for every run, and "Lambda1", "InputModel" and "Test"
+  are ignored.  As the data is given in param, an error is returned if param is
+  nil.  As LARS does not fit an intercept, the responses should usually be
+  centered.
+
+  For example, to choose lambda for the data in X with responses y by 5-fold
+  cross-validation, one could call
+
+  // Initialize optional parameters for Lars().
+  param := mlpack.LarsOptions()
+  param.Input = X
+  param.Responses = y
+
+  result, _ := mlpack.LarsCV(5, param)
+  model := result.Model
+
+ */
+func LarsCV(folds int, param *LarsOptionalParam) (*LarsCVResult, error) {
+  if param == nil || param.Input == nil || param.Responses == nil {
+    return nil, errors.New("input and responses must be given")
+  }
+  n, d := param.Input.Dims()
+  y, err := larsResponses(param.Responses, n)
+  if err != nil {
+    return nil, err
+  }
+  if folds < 2 || folds > n {
+    return nil, errors.New("number of folds must be between 2 and the " +
+                           "number of points")
+  }
+
+  p := *param
+  p.InputModel = nil
+  p.Test = nil
+  p.Lambda1 = larsPathLambda1
+  full, _ := Lars(&p)
+  fullPath := full.Path()
+  if fullPath.Steps() == 0 {
+    return nil, errors.New("LARS returned an empty path")
+  }
+  lambdas := mat.Col(nil, 0, fullPath.Lambdas)
+
+  result := &LarsCVResult{
+    Lambdas: lambdas,
+    Errors: make([]float64, len(lambdas)),
+  }
+  for f := 0; f < folds; f++ {
+    var trainRows, testRows []int
+    for i := 0; i < n; i++ {
+      if i % folds == f {
+        testRows = append(testRows, i)
+      } else {
+        trainRows = append(trainRows, i)
+      }
+    }
+
+    fp := p
+    fp.Input = selectRows(param.Input, trainRows)
+    fp.Responses = mat.NewDense(len(trainRows), 1, nil)
+    for i, row := range trainRows {
+      fp.Responses.Set(i, 0, y[row])
+    }
+    model, _ := Lars(&fp)
+    path := model.Path()
+    if path.Steps() == 0 {
+      return nil, errors.New("LARS returned an empty path")
+    }
+
+    scale := float64(len(trainRows)) / float64(n)
+    for l, lambda := range lambdas {
+      beta := mat.NewVecDense(d, path.At(lambda * scale))
+      for _, row := range testRows {
+        diff := mat.Dot(param.Input.RowView(row), beta) - y[row]
+        result.Errors[l] += diff * diff
+      }
+    }
+  }
+
+  best := math.Inf(1)
+  for l := range result.Errors {
+    result.Errors[l] /= float64(n)
+    if result.Errors[l] < best {
+      best = result.Errors[l]
+      result.Lambda = lambdas[l]
+    }
+  }
+
+  p.Lambda1 = math.Max(result.Lambda, larsPathLambda1)
+  result.Model, _ = Lars(&p)
+  return result, nil
+}
+
+// larsResponses() returns the responses given as a row or column.
+func larsResponses(responses *mat.Dense, n int) ([]float64, error) {
+  r, c := responses.Dims()
+  if (r != 1 && c != 1) || r * c != n {
+    return nil, errors.New("responses must be a vector with one entry per " +
+                           "point")
+  }
+  if c == 1 {
+    return mat.Col(nil, 0, responses), nil
+  }
+  return mat.Row(nil, 0, responses), nil
+}
+
+// selectRows() returns a copy of the given rows of m.
+func selectRows(m *mat.Dense, rows []int) *mat.Dense {
+  _, c := m.Dims()
+  output := mat.NewDense(len(rows), c, nil)
+  for i, row := range rows {
+    output.SetRow(i, m.RawRowView(row))
+  }
+  return output
+}
@@ -0,0 +1,18 @@
+package mlpack
+
+/*
+#cgo CFLAGS: -I./capi -Wall
+#cgo LDFLAGS: -L. -lmlpack_go_lars
+#include <capi/lars.h>
+#include <stdlib.h>
+*/
+import "C"
+
+import "unsafe"
+
+// Coefficients() returns a copy of the coefficient of each dimension.  LARS
+// does not fit an intercept.
+func (m *lars) Coefficients() []float64 {
+  n := int(C.mlpackLARSBetaSize(m.mem))
+  return copyDoubles(unsafe.Pointer(C.mlpackLARSBeta(m.mem)), n)
+}
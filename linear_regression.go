@@ -53,6 +53,10 @@ func LinearRegressionOptions() *LinearRegressionOptionalParam {
   output parameter.  This type of regression is related to least-angle
   regression, which mlpack implements as the 'lars' program.
   
+  The learned b can be read from a trained model with its Intercept() and
+  Coefficients() methods, and its Statistics() method gives the standard
+  errors, t-statistics, R^2 and residuals on the training data.
+  
   For example, to run a linear regression on the dataset X with responses y,
   saving the trained model to lr_model, the following command could be used:
   
@@ -0,0 +1,148 @@
+package mlpack
+
+/*
+#cgo CFLAGS: -I./capi -Wall
+#cgo LDFLAGS: -L. -lmlpack_go_linear_regression
+#include <capi/linear_regression.h>
+#include <stdlib.h>
+*/
+import "C"
+
+import (
+  "errors"
+  "math"
+  "unsafe"
+
+  "gonum.org/v1/gonum/mat"
+)
+
+// Statistics of a linear regression model on its training data.  The standard
+// errors and t-statistics are given for the intercept first, if the model has
+// one, followed by one entry per coefficient.
+type LinearRegressionStats struct {
+  StandardErrors []float64
+  TStatistics []float64
+  RSquared float64
+  Residuals []float64
+}
+
+// Parameters() returns a copy of the parameters of the model: the intercept,
+// if the model has one, followed by one coefficient per dimension.
+func (m *linearRegression) Parameters() []float64 {
+  n := int(C.mlpackLinearRegressionParametersSize(m.mem))
+  return copyDoubles(unsafe.Pointer(C.mlpackLinearRegressionParameters(m.mem)),
+                     n)
+}
+
+// HasIntercept() returns whether the model was trained with an intercept.
+func (m *linearRegression) HasIntercept() bool {
+  return bool(C.mlpackLinearRegressionIntercept(m.mem))
+}
+
+// Lambda() returns the ridge regularization constant the model was trained
+// with.
+func (m *linearRegression) Lambda() float64 {
+  return float64(C.mlpackLinearRegressionLambda(m.mem))
+}
+
+// Intercept() returns the intercept of the model, or 0 if it has none.
+func (m *linearRegression) Intercept() float64 {
+  if !m.HasIntercept() {
+    return 0
+  }
+  return m.Parameters()[0]
+}
+
+// Coefficients() returns the coefficient of each dimension, without the
+// intercept.
+func (m *linearRegression) Coefficients() []float64 {
+  if !m.HasIntercept() {
+    return m.Parameters()
+  }
+  return m.Parameters()[1:]
+}
+
+/*
+  Statistics() computes the residuals, R^2 and the standard errors and
+  t-statistics of the parameters of the model on its training data, given as
+  for the "Training" and "TrainingResponses" parameters of LinearRegression().
+  
+  The standard errors assume independent, homoscedastic errors.  For a model
+  trained with a nonzero "Lambda", the covariance of the ridge estimator,
+  s^2 (X'X + lambda I)^-1 X'X (X'X + lambda I)^-1, is used; with a lambda of 0
+  this is the usual ordinary least squares covariance s^2 (X'X)^-1.
+
+ */
+func (m *linearRegression) Statistics(training *mat.Dense,
+    responses *mat.Dense) (*LinearRegressionStats, error) {
+  n, d := training.Dims()
+  params := m.Parameters()
+  offset := 0
+  if m.HasIntercept() {
+    offset = 1
+  }
+  p := d + offset
+  if len(params) != p {
+    return nil, errors.New("training data dimensionality does not match " +
+                           "the model")
+  }
+  if r, c := responses.Dims(); (r != 1 && c != 1) || r * c != n {
+    return nil, errors.New("responses must be a vector with one entry per " +
+                           "training point")
+  }
+  if n <= p {
+    return nil, errors.New("more training points than parameters are needed")
+  }
+
+  // The design matrix, with a leading column of ones for the intercept.
+  x := mat.NewDense(n, p, nil)
+  for i := 0; i < n; i++ {
+    if offset == 1 {
+      x.Set(i, 0, 1)
+    }
+    for j := 0; j < d; j++ {
+      x.Set(i, j + offset, training.At(i, j))
+    }
+  }
+  y := mat.NewVecDense(n, nil)
+  for i := 0; i < n; i++ {
+    if _, c := responses.Dims(); c == 1 {
+      y.SetVec(i, responses.At(i, 0))
+    } else {
+      y.SetVec(i, responses.At(0, i))
+    }
+  }
+
+  stats := &LinearRegressionStats{Residuals: make([]float64, n)}
+  var fitted mat.VecDense
+  fitted.MulVec(x, mat.NewVecDense(p, params))
+  mean := mat.Sum(y) / float64(n)
+  rss, tss := 0.0, 0.0
+  for i := 0; i < n; i++ {
+    stats.Residuals[i] = y.AtVec(i) - fitted.AtVec(i)
+    rss += stats.Residuals[i] * stats.Residuals[i]
+    tss += (y.AtVec(i) - mean) * (y.AtVec(i) - mean)
+  }
+  stats.RSquared = 1 - rss / tss
+  sigma2 := rss / float64(n - p)
+
+  var xtx, a mat.Dense
+  xtx.Mul(x.T(), x)
+  a.CloneFrom(&xtx)
+  for i := 0; i < p; i++ {
+    a.Set(i, i, a.At(i, i) + m.Lambda())
+  }
+  if err := a.Inverse(&a); err != nil {
+    return nil, err
+  }
+  var cov mat.Dense
+  cov.Product(&a, &xtx, &a)
+
+  stats.StandardErrors = make([]float64, p)
+  stats.TStatistics = make([]float64, p)
+  for i := 0; i < p; i++ {
+    stats.StandardErrors[i] = math.Sqrt(sigma2 * cov.At(i, i))
+    stats.TStatistics[i] = params[i] / stats.StandardErrors[i]
+  }
+  return stats, nil
+}
@@ -114,6 +114,10 @@ func LinearSvmOptions() *LinearSvmOptionalParam {
   param.Test = test
   
   _, predictions, _ := mlpack.LinearSvm(param)
+  
+  The raw score of each class for new points, before it is turned into a
+  prediction, can be computed with the DecisionFunction() method of a trained
+  model.
 
 
   Input parameters:
@@ -0,0 +1,78 @@
+package mlpack
+
+/*
+#cgo CFLAGS: -I./capi -Wall
+#cgo LDFLAGS: -L. -lmlpack_go_linear_svm
+#include <capi/linear_svm.h>
+#include <stdlib.h>
+*/
+import "C"
+
+import (
+  "unsafe"
+
+  "gonum.org/v1/gonum/mat"
+)
+
+// NumClasses() returns the number of classes of the model.
+func (m *linearsvmModel) NumClasses() int {
+  return int(C.mlpackLinearSVMNumClasses(m.mem))
+}
+
+// HasIntercept() returns whether the model was trained with an intercept, that
+// is, without the "NoIntercept" parameter.
+func (m *linearsvmModel) HasIntercept() bool {
+  return bool(C.mlpackLinearSVMFitIntercept(m.mem))
+}
+
+// Classes() returns the original label of each class, in the order of the rows
+// of Parameters().
+func (m *linearsvmModel) Classes() []int {
+  output := make([]int, m.NumClasses())
+  for i := range output {
+    output[i] = int(C.mlpackLinearSVMMapping(m.mem, C.size_t(i)))
+  }
+  return output
+}
+
+// Parameters() returns a copy of the parameters of the model, one row per
+// class.  If the model has an intercept, it is the last column.
+func (m *linearsvmModel) Parameters() *mat.Dense {
+  // The parameters are stored as a column-major rows x classes matrix, so
+  // their memory already holds one class per row.
+  k := m.NumClasses()
+  rows := int(C.mlpackLinearSVMParametersRows(m.mem))
+  data := copyDoubles(unsafe.Pointer(C.mlpackLinearSVMParameters(m.mem)),
+                      rows * k)
+  return mat.NewDense(k, rows, data)
+}
+
+// Weights() returns the weights of each class, one row per class and one
+// column per dimension.
+func (m *linearsvmModel) Weights() *mat.Dense {
+  params := m.Parameters()
+  if !m.HasIntercept() {
+    return params
+  }
+  k, cols := params.Dims()
+  return mat.DenseCopyOf(params.Slice(0, k, 0, cols - 1))
+}
+
+// Intercepts() returns the intercept of each class, or zeros if the model has
+// no intercept.
+func (m *linearsvmModel) Intercepts() []float64 {
+  params := m.Parameters()
+  k, cols := params.Dims()
+  if !m.HasIntercept() {
+    return make([]float64, k)
+  }
+  return mat.Col(nil, cols - 1, params)
+}
+
+// DecisionFunction() returns the raw score of each class for each row of
+// points, one row per point and one column per class in the order of
+// Classes().  The predicted class of a point is the one with the highest score.
+func (m *linearsvmModel) DecisionFunction(
+    points *mat.Dense) (*mat.Dense, error) {
+  return linearDecisionFunction(points, m.Weights(), m.Intercepts())
+}
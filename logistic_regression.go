@@ -23,6 +23,7 @@ type LogisticRegressionOptionalParam struct {
     Tolerance float64
     Training *mat.Dense
     Verbose bool
+    WarmStart bool
 }
 
 func LogisticRegressionOptions() *LogisticRegressionOptionalParam {
@@ -39,6 +40,7 @@ func LogisticRegressionOptions() *LogisticRegressionOptionalParam {
     Tolerance: 1e-10,
     Training: nil,
     Verbose: false,
+    WarmStart: false,
   }
 }
 
@@ -87,6 +89,11 @@ func LogisticRegressionOptions() *LogisticRegressionOptionalParam {
   model is given with the "InputModel" parameter.  The output predictions from
   the logistic regression model may be saved with the "Predictions" parameter.
   
+  If "WarmStart" is specified along with "InputModel" and "Training", the
+  optimizer starts from the parameters of the input model, so a model can be
+  refreshed on new data without the history it was trained on; see also
+  LogisticRegressionPartialFit().
+  
   Note : The following parameters are deprecated and will be removed in mlpack
   4: "Output", "OutputProbabilities"
   Use "Predictions" instead of "Output"
@@ -143,6 +150,8 @@ func LogisticRegressionOptions() *LogisticRegressionOptionalParam {
         matrix of predictors, X).
    - Verbose (bool): Display informational messages and the full list of
         parameters and timers at the end of execution.
+   - WarmStart (bool): If set, continue training the input model on the
+        training data instead of training a new model.
 
   Output parameters:
 
@@ -238,6 +247,12 @@ func LogisticRegression(param *LogisticRegressionOptionalParam) (*mat.Dense, log
     enableVerbose()
   }
 
+  // Detect if the parameter was passed; set if so.
+  if param.WarmStart != false {
+    setParamBool("warm_start", param.WarmStart)
+    setPassed("warm_start")
+  }
+
   // Mark all output options as passed.
   setPassed("output")
   setPassed("output_model")
@@ -0,0 +1,30 @@
+package mlpack
+
+/*
+#cgo CFLAGS: -I./capi -Wall
+#cgo LDFLAGS: -L. -lmlpack_go_logistic_regression
+#include <capi/logistic_regression.h>
+#include <stdlib.h>
+*/
+import "C"
+
+import "unsafe"
+
+// Parameters() returns a copy of the parameters of the model: the intercept
+// followed by one coefficient per dimension.
+func (m *logisticRegression) Parameters() []float64 {
+  n := int(C.mlpackLogisticRegressionParametersSize(m.mem))
+  return copyDoubles(
+      unsafe.Pointer(C.mlpackLogisticRegressionParameters(m.mem)), n)
+}
+
+// Intercept() returns the intercept of the model.
+func (m *logisticRegression) Intercept() float64 {
+  return m.Parameters()[0]
+}
+
+// Coefficients() returns the coefficient of each dimension, without the
+// intercept.
+func (m *logisticRegression) Coefficients() []float64 {
+  return m.Parameters()[1:]
+}
@@ -17,6 +17,7 @@ type NbcOptionalParam struct {
     Test *mat.Dense
     Training *mat.Dense
     Verbose bool
+    WarmStart bool
 }
 
 func NbcOptions() *NbcOptionalParam {
@@ -27,6 +28,7 @@ func NbcOptions() *NbcOptionalParam {
     Test: nil,
     Training: nil,
     Verbose: false,
+    WarmStart: false,
   }
 }
 
@@ -40,7 +42,10 @@ func NbcOptions() *NbcOptionalParam {
   may be specified to pass a separate matrix of labels.
   
   If training is not desired, a pre-existing model may be loaded with the
-  "InputModel" parameter.
+  "InputModel" parameter.  If "WarmStart" is also specified along with
+  "Training", the loaded model is updated with the new training data instead of
+  being replaced, so a model can be refreshed batch by batch; see also
+  NbcPartialFit().
   
   
   
@@ -87,6 +92,8 @@ func NbcOptions() *NbcOptionalParam {
    - Training (mat.Dense): A matrix containing the training set.
    - Verbose (bool): Display informational messages and the full list of
         parameters and timers at the end of execution.
+   - WarmStart (bool): If set, continue training the input model on the
+        training data instead of training a new model.
 
   Output parameters:
 
@@ -145,6 +152,12 @@ func Nbc(param *NbcOptionalParam) (*mat.Dense, nbcModel, *mat.Dense, *mat.Dense,
     enableVerbose()
   }
 
+  // Detect if the parameter was passed; set if so.
+  if param.WarmStart != false {
+    setParamBool("warm_start", param.WarmStart)
+    setPassed("warm_start")
+  }
+
   // Mark all output options as passed.
   setPassed("output")
   setPassed("output_model")
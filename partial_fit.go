@@ -0,0 +1,69 @@
+package mlpack
+
+import "gonum.org/v1/gonum/mat"
+
+// The PartialFit() functions below continue training a model on a new batch of
+// points x with labels y, keeping what the model learned from earlier batches,
+// so a model can be refreshed regularly without reloading its history.  If
+// model is nil, a new model is trained on the batch.  The labels of every batch
+// must use the same classes, and the dimensionality of every batch must match
+// the model.  The remaining settings are taken from param, which may be nil to
+// use the defaults.  Each function returns the updated model.
+
+// NbcPartialFit() updates the class means, variances and priors of a Naive
+// Bayes classifier with a new batch of points.  The result is the same as
+// training once on all batches together.
+func NbcPartialFit(model *nbcModel, x *mat.Dense, y *mat.Dense,
+                   param *NbcOptionalParam) nbcModel {
+  p := NbcOptions()
+  if param != nil {
+    *p = *param
+  }
+  p.InputModel = model
+  p.Training = x
+  p.Labels = y
+  p.Test = nil
+  p.WarmStart = model != nil
+
+  _, outputModel, _, _, _ := Nbc(p)
+  return outputModel
+}
+
+// PerceptronPartialFit() runs perceptron training on a new batch of points,
+// starting from the current weights of the model.
+func PerceptronPartialFit(model *perceptronModel, x *mat.Dense, y *mat.Dense,
+                          param *PerceptronOptionalParam) perceptronModel {
+  p := PerceptronOptions()
+  if param != nil {
+    *p = *param
+  }
+  p.InputModel = model
+  p.Training = x
+  p.Labels = y
+  p.Test = nil
+  p.WarmStart = model != nil
+
+  _, outputModel, _ := Perceptron(p)
+  return outputModel
+}
+
+// LogisticRegressionPartialFit() optimizes a logistic regression model on a
+// new batch of points, starting from the current parameters of the model.  With
+// the 'sgd' optimizer this amounts to further passes of stochastic gradient
+// descent over the new data.
+func LogisticRegressionPartialFit(model *logisticRegression, x *mat.Dense,
+                                  y *mat.Dense,
+                                  param *LogisticRegressionOptionalParam) logisticRegression {
+  p := LogisticRegressionOptions()
+  if param != nil {
+    *p = *param
+  }
+  p.InputModel = model
+  p.Training = x
+  p.Labels = y
+  p.Test = nil
+  p.WarmStart = model != nil
+
+  _, outputModel, _, _, _ := LogisticRegression(p)
+  return outputModel
+}
@@ -55,6 +55,9 @@ func PcaOptions() *PcaOptionalParam {
   param.DecompositionMethod = "randomized"
   
   data_mod := mlpack.Pca(data, param)
+  
+  To keep the principal components, so that new points can be projected in the
+  same way, use PcaFit() instead.
 
 
   Input parameters:
@@ -79,6 +82,24 @@ func PcaOptions() *PcaOptionalParam {
 
  */
 func Pca(input *mat.Dense, param *PcaOptionalParam) (*mat.Dense) {
+  runPca(input, param, false)
+
+  // Initialize result variable and get output.
+  var outputPtr mlpackArma
+  output := outputPtr.armaToGonumMat("output")
+
+  // Clear settings.
+  clearSettings()
+
+  // Return output(s).
+  return output
+}
+
+// runPca runs PCA on the input.  If model is set, the eigenvalues,
+// eigenvectors, mean and standard deviations PcaFit() builds its model from are
+// requested along with the transformed data.  The caller reads the outputs and
+// clears the settings.
+func runPca(input *mat.Dense, param *PcaOptionalParam, model bool) {
   resetTimers()
   enableTimers()
   disableBacktrace()
@@ -122,17 +143,13 @@ func Pca(input *mat.Dense, param *PcaOptionalParam) (*mat.Dense) {
 
   // Mark all output options as passed.
   setPassed("output")
+  if model {
+    setPassed("eigenvalues")
+    setPassed("eigenvectors")
+    setPassed("mean")
+    setPassed("stddev")
+  }
 
   // Call the mlpack program.
   C.mlpackPca()
-
-  // Initialize result variable and get output.
-  var outputPtr mlpackArma
-  output := outputPtr.armaToGonumMat("output")
-
-  // Clear settings.
-  clearSettings()
-
-  // Return output(s).
-  return output
 }
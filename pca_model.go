@@ -0,0 +1,177 @@
+package mlpack
+
+import (
+  "bytes"
+  "encoding/gob"
+  "errors"
+
+  "gonum.org/v1/gonum/mat"
+)
+
+// A fitted principal components analysis model.  Each row of Components is a
+// principal component, ordered by decreasing eigenvalue; only the first
+// NumComponents are used for the projection.  Eigenvalues holds the variance
+// of the data along each component, and TotalVariance the sum of the variances
+// of all dimensions of the centered (and scaled) training data.  Points are
+// centered with Mean and, if the model was fitted with the "Scale" parameter,
+// divided by StdDev.
+type pcaModel struct {
+  Components *mat.Dense
+  Eigenvalues []float64
+  Mean []float64
+  StdDev []float64
+  NumComponents int
+  TotalVariance float64
+}
+
+// PcaFit() runs Pca() on the input and returns the fitted model along with the
+// transformed input.  All "DecompositionMethod" choices are supported.  If
+// param is nil, the defaults of PcaOptions() are used.
+func PcaFit(input *mat.Dense, param *PcaOptionalParam) (*pcaModel, *mat.Dense) {
+  if param == nil {
+    param = PcaOptions()
+  }
+  runPca(input, param, true)
+
+  // Initialize result variable and get output.
+  var outputPtr mlpackArma
+  output := outputPtr.armaToGonumMat("output")
+  var eigenvaluesPtr mlpackArma
+  eigenvalues := eigenvaluesPtr.armaToGonumCol("eigenvalues")
+  var eigenvectorsPtr mlpackArma
+  eigenvectors := eigenvectorsPtr.armaToGonumMat("eigenvectors")
+  var meanPtr mlpackArma
+  mean := meanPtr.armaToGonumCol("mean")
+  var stddev []float64
+  if param.Scale {
+    var stddevPtr mlpackArma
+    stddev = stddevPtr.armaToGonumCol("stddev").RawRowView(0)
+  }
+
+  // Clear settings.
+  clearSettings()
+
+  _, k := output.Dims()
+  m := &pcaModel{
+    Components: eigenvectors,
+    Eigenvalues: eigenvalues.RawRowView(0),
+    Mean: mean.RawRowView(0),
+    StdDev: stddev,
+    NumComponents: k,
+  }
+
+  // The eigenvalues of the randomized and QUIC decompositions are only
+  // approximations, and not all of them may be returned, so the total variance
+  // is computed from the data itself, with the same normalization as the
+  // covariance matrix decomposed by mlpack.
+  n, _ := input.Dims()
+  if n > 1 {
+    for _, v := range m.center(input).RawMatrix().Data {
+      m.TotalVariance += v * v
+    }
+    m.TotalVariance /= float64(n - 1)
+  }
+  return m, output
+}
+
+// Transform() projects each row of points onto the retained principal
+// components.  An error is returned if points does not have the dimensionality
+// of the training data.
+func (m *pcaModel) Transform(points *mat.Dense) (*mat.Dense, error) {
+  r, d := points.Dims()
+  if d != len(m.Mean) {
+    return nil, errors.New("points must have the same number of columns as " +
+                           "the training data")
+  }
+
+  output := mat.NewDense(r, m.NumComponents, nil)
+  output.Mul(m.center(points), m.retained().T())
+  return output, nil
+}
+
+// InverseTransform() maps each row of projected points from the space of the
+// retained principal components back to the original space.  An error is
+// returned if projected does not have one column per retained component.
+func (m *pcaModel) InverseTransform(projected *mat.Dense) (*mat.Dense, error) {
+  r, c := projected.Dims()
+  if c != m.NumComponents {
+    return nil, errors.New("projected points must have one column per " +
+                           "retained component")
+  }
+  _, d := m.Components.Dims()
+
+  output := mat.NewDense(r, d, nil)
+  output.Mul(projected, m.retained())
+  output.Apply(func(i, j int, v float64) float64 {
+    if m.StdDev != nil && m.StdDev[j] != 0 {
+      v *= m.StdDev[j]
+    }
+    return v + m.Mean[j]
+  }, output)
+  return output, nil
+}
+
+// ExplainedVariance() returns the variance explained by each retained
+// principal component.
+func (m *pcaModel) ExplainedVariance() []float64 {
+  return append([]float64(nil), m.Eigenvalues[:m.NumComponents]...)
+}
+
+// ExplainedVarianceRatio() returns the fraction of the total variance of the
+// training data explained by each retained principal component.
+func (m *pcaModel) ExplainedVarianceRatio() []float64 {
+  output := m.ExplainedVariance()
+  for i := range output {
+    output[i] /= m.TotalVariance
+  }
+  return output
+}
+
+// center() centers each row of points with Mean and scales it with StdDev.
+func (m *pcaModel) center(points *mat.Dense) *mat.Dense {
+  r, d := points.Dims()
+  centered := mat.NewDense(r, d, nil)
+  centered.Apply(func(i, j int, v float64) float64 {
+    v -= m.Mean[j]
+    if m.StdDev != nil && m.StdDev[j] != 0 {
+      v /= m.StdDev[j]
+    }
+    return v
+  }, points)
+  return centered
+}
+
+func (m *pcaModel) retained() mat.Matrix {
+  _, d := m.Components.Dims()
+  return m.Components.Slice(0, m.NumComponents, 0, d)
+}
+
+// pcaModelData is pcaModel without its MarshalBinary() method, which gob
+// would otherwise call back.
+type pcaModelData pcaModel
+
+// MarshalBinary() serializes the model.
+func (m *pcaModel) MarshalBinary() ([]byte, error) {
+  return gobMarshal((*pcaModelData)(m))
+}
+
+// UnmarshalBinary() deserializes a model serialized with MarshalBinary().
+func (m *pcaModel) UnmarshalBinary(data []byte) error {
+  return gobUnmarshal(data, (*pcaModelData)(m))
+}
+
+// gobMarshal() encodes v with gob.  Models that serialize themselves this way
+// pass a pointer to a type with the same fields but without their methods, as
+// gob calls MarshalBinary() and UnmarshalBinary() when they exist.
+func gobMarshal(v interface{}) ([]byte, error) {
+  var buf bytes.Buffer
+  if err := gob.NewEncoder(&buf).Encode(v); err != nil {
+    return nil, err
+  }
+  return buf.Bytes(), nil
+}
+
+// gobUnmarshal() decodes data encoded by gobMarshal() into v.
+func gobUnmarshal(data []byte, v interface{}) error {
+  return gob.NewDecoder(bytes.NewReader(data)).Decode(v)
+}
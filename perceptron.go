@@ -17,6 +17,7 @@ type PerceptronOptionalParam struct {
     Test *mat.Dense
     Training *mat.Dense
     Verbose bool
+    WarmStart bool
 }
 
 func PerceptronOptions() *PerceptronOptionalParam {
@@ -27,6 +28,7 @@ func PerceptronOptions() *PerceptronOptionalParam {
     Test: nil,
     Training: nil,
     Verbose: false,
+    WarmStart: false,
   }
 }
 
@@ -81,12 +83,18 @@ func PerceptronOptions() *PerceptronOptionalParam {
   
   Note that all of the options may be specified at once: predictions may be
   calculated right after training a model, and model training can occur even if
-  an existing perceptron model is passed with the "InputModel" parameter. 
+  an existing perceptron model is passed with the "InputModel" parameter.  When
+  "WarmStart" is specified, training is guaranteed to continue from the weights
+  of the input model rather than from a fresh initialization; see also
+  PerceptronPartialFit().
   However, note that the number of classes and the dimensionality of all data
   must match.  So you cannot pass a perceptron model trained on 2 classes and
   then re-train with a 4-class dataset.  Similarly, attempting classification on
   a 3-dimensional dataset with a perceptron that has been trained on 8
   dimensions will cause an error.
+  
+  The raw score of each class for new points, as used to choose the predicted
+  class, can be computed with the DecisionFunction() method of a trained model.
 
 
   Input parameters:
@@ -99,6 +107,8 @@ func PerceptronOptions() *PerceptronOptionalParam {
    - Training (mat.Dense): A matrix containing the training set.
    - Verbose (bool): Display informational messages and the full list of
         parameters and timers at the end of execution.
+   - WarmStart (bool): If set, continue training the input model on the
+        training data instead of training a new model.
 
   Output parameters:
 
@@ -153,6 +163,12 @@ func Perceptron(param *PerceptronOptionalParam) (*mat.Dense, perceptronModel, *m
     enableVerbose()
   }
 
+  // Detect if the parameter was passed; set if so.
+  if param.WarmStart != false {
+    setParamBool("warm_start", param.WarmStart)
+    setPassed("warm_start")
+  }
+
   // Mark all output options as passed.
   setPassed("output")
   setPassed("output_model")
@@ -0,0 +1,78 @@
+package mlpack
+
+/*
+#cgo CFLAGS: -I./capi -Wall
+#cgo LDFLAGS: -L. -lmlpack_go_perceptron
+#include <capi/perceptron.h>
+#include <stdlib.h>
+*/
+import "C"
+
+import (
+  "errors"
+  "unsafe"
+
+  "gonum.org/v1/gonum/mat"
+)
+
+// NumClasses() returns the number of classes of the model.
+func (m *perceptronModel) NumClasses() int {
+  return int(C.mlpackPerceptronNumClasses(m.mem))
+}
+
+// Classes() returns the original label of each class, in the order of the rows
+// of Weights().
+func (m *perceptronModel) Classes() []int {
+  output := make([]int, m.NumClasses())
+  for i := range output {
+    output[i] = int(C.mlpackPerceptronMapping(m.mem, C.size_t(i)))
+  }
+  return output
+}
+
+// Weights() returns a copy of the weights of each class, one row per class and
+// one column per dimension.
+func (m *perceptronModel) Weights() *mat.Dense {
+  // The weights are stored as a column-major dimensions x classes matrix, so
+  // their memory already holds one class per row.
+  k := m.NumClasses()
+  d := int(C.mlpackPerceptronDimensionality(m.mem))
+  data := copyDoubles(unsafe.Pointer(C.mlpackPerceptronWeights(m.mem)), d * k)
+  return mat.NewDense(k, d, data)
+}
+
+// Intercepts() returns a copy of the bias of each class.
+func (m *perceptronModel) Intercepts() []float64 {
+  return copyDoubles(unsafe.Pointer(C.mlpackPerceptronBiases(m.mem)),
+                     m.NumClasses())
+}
+
+// DecisionFunction() returns the raw score of each class for each row of
+// points, one row per point and one column per class in the order of
+// Classes().  The predicted class of a point is the one with the highest score.
+func (m *perceptronModel) DecisionFunction(
+    points *mat.Dense) (*mat.Dense, error) {
+  return linearDecisionFunction(points, m.Weights(), m.Intercepts())
+}
+
+// linearDecisionFunction() returns points * weights' plus the intercept of each
+// class, where weights holds one row per class.
+func linearDecisionFunction(points *mat.Dense, weights *mat.Dense,
+                            intercepts []float64) (*mat.Dense, error) {
+  _, d := points.Dims()
+  k, wd := weights.Dims()
+  if d != wd {
+    return nil, errors.New("points must have the dimensionality of the model")
+  }
+
+  var output mat.Dense
+  output.Mul(points, weights.T())
+  r, _ := output.Dims()
+  for i := 0; i < r; i++ {
+    row := output.RawRowView(i)
+    for j := 0; j < k; j++ {
+      row[j] += intercepts[j]
+    }
+  }
+  return &output, nil
+}
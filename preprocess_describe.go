@@ -34,9 +34,9 @@ func PreprocessDescribeOptions() *PreprocessDescribeOptionalParam {
   This utility takes a dataset and prints out the descriptive statistics of the
   data. Descriptive statistics is the discipline of quantitatively describing
   the main features of a collection of information, or the quantitative
-  description itself. The program does not modify the original file, but instead
-  prints out the statistics to the console. The printed result will look like a
-  table.
+  description itself. The program does not modify the original data, but instead
+  returns the statistics of each dimension.  When "Verbose" is given, the
+  statistics are also printed to the console as a table.
   
   Optionally, width and precision of the output can be adjusted by a user using
   the "Width" and "Precision" parameters. A user can also select a specific
@@ -51,7 +51,7 @@ func PreprocessDescribeOptions() *PreprocessDescribeOptionalParam {
   param := mlpack.PreprocessDescribeOptions()
   param.Verbose = true
   
-   := mlpack.PreprocessDescribe(X, param)
+  statistics := mlpack.PreprocessDescribe(X, param)
   
   If we want to customize the width to 10 and precision to 5 and consider the
   dataset as a population, we could run
@@ -62,7 +62,11 @@ func PreprocessDescribeOptions() *PreprocessDescribeOptionalParam {
   param.Precision = 5
   param.Verbose = true
   
-   := mlpack.PreprocessDescribe(X, param)
+  statistics := mlpack.PreprocessDescribe(X, param)
+  
+  The covariance and correlation matrices and arbitrary quantiles of the data
+  can be computed with DescribeCovariance(), DescribeCorrelation() and
+  DescribeQuantiles().
 
 
   Input parameters:
@@ -84,9 +88,11 @@ func PreprocessDescribeOptions() *PreprocessDescribeOptionalParam {
 
   Output parameters:
 
+   - statistics ([]DimensionStatistics): The statistics of each described
+        dimension.
 
  */
-func PreprocessDescribe(input *mat.Dense, param *PreprocessDescribeOptionalParam) () {
+func PreprocessDescribe(input *mat.Dense, param *PreprocessDescribeOptionalParam) ([]DimensionStatistics) {
   resetTimers()
   enableTimers()
   disableBacktrace()
@@ -135,15 +141,18 @@ func PreprocessDescribe(input *mat.Dense, param *PreprocessDescribeOptionalParam
   }
 
   // Mark all output options as passed.
+  setPassed("statistics")
 
   // Call the mlpack program.
   C.mlpackPreprocessDescribe()
 
   // Initialize result variable and get output.
+  var statisticsPtr mlpackArma
+  statistics := toDimensionStatistics(statisticsPtr.armaToGonumMat("statistics"))
 
   // Clear settings.
   clearSettings()
 
   // Return output(s).
-  return 
+  return statistics
 }
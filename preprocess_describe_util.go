@@ -0,0 +1,142 @@
+package mlpack
+
+import (
+  "errors"
+  "sort"
+
+  "gonum.org/v1/gonum/mat"
+  "gonum.org/v1/gonum/stat"
+)
+
+// The descriptive statistics of a single dimension, as computed by
+// PreprocessDescribe().  StdDev, Variance, Skewness and Kurtosis are sample
+// statistics unless the "Population" parameter was given.
+type DimensionStatistics struct {
+  Dimension int
+  Min float64
+  Max float64
+  Mean float64
+  Median float64
+  StdDev float64
+  Variance float64
+  Skewness float64
+  Kurtosis float64
+}
+
+// toDimensionStatistics() converts the statistics matrix returned by mlpack,
+// which holds one row per dimension with the columns in the order of the
+// DimensionStatistics fields, to a slice of DimensionStatistics.
+func toDimensionStatistics(m *mat.Dense) []DimensionStatistics {
+  r, c := m.Dims()
+  if c != 9 {
+    return []DimensionStatistics{}
+  }
+
+  output := make([]DimensionStatistics, r)
+  for i := 0; i < r; i++ {
+    output[i] = DimensionStatistics{
+      Dimension: int(m.At(i, 0)),
+      Min: m.At(i, 1),
+      Max: m.At(i, 2),
+      Mean: m.At(i, 3),
+      Median: m.At(i, 4),
+      StdDev: m.At(i, 5),
+      Variance: m.At(i, 6),
+      Skewness: m.At(i, 7),
+      Kurtosis: m.At(i, 8),
+    }
+  }
+  return output
+}
+
+// describeData() returns the input with one point per row, transposing it if
+// "RowMajor" was given.
+func describeData(input *mat.Dense, param *PreprocessDescribeOptionalParam) *mat.Dense {
+  if param.RowMajor {
+    return mat.DenseCopyOf(input.T())
+  }
+  return input
+}
+
+// The Describe*() functions below compute statistics that PreprocessDescribe()
+// does not give.  They are computed in Go, without calling mlpack.  param may
+// be nil to use the defaults of PreprocessDescribeOptions().
+
+// DescribeCovariance() returns the covariance matrix of all dimensions of the
+// input.  The "Population" and "RowMajor" parameters are honored; "Dimension"
+// is ignored.
+func DescribeCovariance(input *mat.Dense, param *PreprocessDescribeOptionalParam) *mat.SymDense {
+  p := PreprocessDescribeOptions()
+  if param != nil {
+    *p = *param
+  }
+  data := describeData(input, p)
+  n, _ := data.Dims()
+
+  var cov mat.SymDense
+  stat.CovarianceMatrix(&cov, data, nil)
+  if p.Population && n > 1 {
+    cov.ScaleSym(float64(n - 1) / float64(n), &cov)
+  }
+  return &cov
+}
+
+// DescribeCorrelation() returns the Pearson correlation matrix of all
+// dimensions of the input.  The "RowMajor" parameter is honored; "Dimension" is
+// ignored.
+func DescribeCorrelation(input *mat.Dense, param *PreprocessDescribeOptionalParam) *mat.SymDense {
+  p := PreprocessDescribeOptions()
+  if param != nil {
+    *p = *param
+  }
+  var corr mat.SymDense
+  stat.CorrelationMatrix(&corr, describeData(input, p), nil)
+  return &corr
+}
+
+// DescribeQuantiles() returns the given quantiles (each in [0, 1]) of every
+// dimension of the input, one row per dimension and one column per quantile.
+// If "Dimension" is given, only that dimension is described.  The "RowMajor"
+// parameter is honored.  An error is returned if "Dimension" is out of range or
+// a quantile is outside [0, 1].
+func DescribeQuantiles(input *mat.Dense, quantiles []float64,
+                       param *PreprocessDescribeOptionalParam) (*mat.Dense, error) {
+  if len(quantiles) == 0 {
+    return nil, nil
+  }
+  for _, q := range quantiles {
+    if !(q >= 0 && q <= 1) {
+      return nil, errors.New("quantiles must be between 0 and 1")
+    }
+  }
+  p := PreprocessDescribeOptions()
+  if param != nil {
+    *p = *param
+  }
+  data := describeData(input, p)
+  n, d := data.Dims()
+  if p.Dimension < 0 || p.Dimension >= d {
+    return nil, errors.New("dimension must be between 0 and the number of " +
+                           "dimensions minus 1")
+  }
+
+  dims := make([]int, 0, d)
+  if p.Dimension != 0 {
+    dims = append(dims, p.Dimension)
+  } else {
+    for j := 0; j < d; j++ {
+      dims = append(dims, j)
+    }
+  }
+
+  output := mat.NewDense(len(dims), len(quantiles), nil)
+  values := make([]float64, n)
+  for i, j := range dims {
+    mat.Col(values, j, data)
+    sort.Float64s(values)
+    for k, q := range quantiles {
+      output.Set(i, k, stat.Quantile(q, stat.LinInterp, values, nil))
+    }
+  }
+  return output, nil
+}
@@ -24,6 +24,7 @@ type RandomForestOptionalParam struct {
     TestLabels *mat.Dense
     Training *mat.Dense
     Verbose bool
+    WarmStart bool
 }
 
 func RandomForestOptions() *RandomForestOptionalParam {
@@ -41,6 +42,7 @@ func RandomForestOptions() *RandomForestOptionalParam {
     TestLabels: nil,
     Training: nil,
     Verbose: false,
+    WarmStart: false,
   }
 }
 
@@ -58,15 +60,16 @@ func RandomForestOptions() *RandomForestOptionalParam {
   When a model is trained, the "OutputModel" output parameter may be used to
   save the trained model.  A model may be loaded for predictions with the
   "InputModel"parameter. The "InputModel" parameter may not be specified when
-  the "Training" parameter is specified.  The "MinimumLeafSize" parameter
-  specifies the minimum number of training points that must fall into each leaf
-  for it to be split.  The "NumTrees" controls the number of trees in the random
-  forest.  The "MinimumGainSplit" parameter controls the minimum required gain
-  for a decision tree node to split.  Larger values will force higher-confidence
-  splits.  The "MaximumDepth" parameter specifies the maximum depth of the tree.
-   The "SubspaceDim" parameter is used to control the number of random
-  dimensions chosen for an individual node's split.  If "PrintTrainingAccuracy"
-  is specified, the calculated accuracy on the training set will be printed.
+  the "Training" parameter is specified, unless "WarmStart" is specified.  The
+  "MinimumLeafSize" parameter specifies the minimum number of training points
+  that must fall into each leaf for it to be split.  The "NumTrees" controls the
+  number of trees in the random forest.  The "MinimumGainSplit" parameter
+  controls the minimum required gain for a decision tree node to split.  Larger
+  values will force higher-confidence splits.  The "MaximumDepth" parameter
+  specifies the maximum depth of the tree.  The "SubspaceDim" parameter is used
+  to control the number of random dimensions chosen for an individual node's
+  split.  If "PrintTrainingAccuracy" is specified, the calculated accuracy on
+  the training set will be printed.
   
   Test data may be specified with the "Test" parameter, and if performance
   measures are desired for that test set, labels for the test points may be
@@ -99,6 +102,25 @@ func RandomForestOptions() *RandomForestOptionalParam {
   param.TestLabels = test_labels
   
   _, predictions, _ := mlpack.RandomForest(param)
+  
+  An existing forest can be grown instead of retrained from scratch.  When
+  "WarmStart" is specified together with "InputModel", "Training" and "Labels",
+  "NumTrees" new trees are trained on the given data and appended to the trees
+  of the input model.  For example, to add 20 trees trained on new_data with
+  labels new_labels to rf_model, one could call
+  
+  // Initialize optional parameters for RandomForest().
+  param := mlpack.RandomForestOptions()
+  param.InputModel = &rf_model
+  param.Training = new_data
+  param.Labels = new_labels
+  param.NumTrees = 20
+  param.WarmStart = true
+  
+  rf_model, _, _ = mlpack.RandomForest(param)
+  
+  To also get the out-of-bag accuracy and the feature importances of a newly
+  trained forest, use RandomForestTrain() instead.
 
 
   Input parameters:
@@ -112,8 +134,8 @@ func RandomForestOptions() *RandomForestOptionalParam {
         building a tree.  Default value 0.
    - MinimumLeafSize (int): Minimum number of points in each leaf node. 
         Default value 1.
-   - NumTrees (int): Number of trees in the random forest.  Default value
-        10.
+   - NumTrees (int): Number of trees in the random forest, or number of
+        trees to add if "WarmStart" is specified.  Default value 10.
    - PrintTrainingAccuracy (bool): If set, then the accuracy of the model
         on the training set will be predicted (verbose must also be specified).
    - Seed (int): Random seed.  If 0, 'std::time(NULL)' is used.  Default
@@ -127,6 +149,8 @@ func RandomForestOptions() *RandomForestOptionalParam {
    - Training (mat.Dense): Training dataset.
    - Verbose (bool): Display informational messages and the full list of
         parameters and timers at the end of execution.
+   - WarmStart (bool): If set, train "NumTrees" new trees on the training
+        data and append them to the trees of the input model.
 
   Output parameters:
 
@@ -139,6 +163,28 @@ func RandomForestOptions() *RandomForestOptionalParam {
 
  */
 func RandomForest(param *RandomForestOptionalParam) (randomForestModel, *mat.Dense, *mat.Dense) {
+  runRandomForest(param, false)
+
+  // Initialize result variable and get output.
+  var outputModel randomForestModel
+  outputModel.getRandomForestModel("output_model")
+  var predictionsPtr mlpackArma
+  predictions := predictionsPtr.armaToGonumUrow("predictions")
+  var probabilitiesPtr mlpackArma
+  probabilities := probabilitiesPtr.armaToGonumMat("probabilities")
+
+  // Clear settings.
+  clearSettings()
+
+  // Return output(s).
+  return outputModel, predictions, probabilities
+}
+
+// runRandomForest trains or applies a random forest.  The out-of-bag accuracy
+// and feature importances cost extra work in mlpack, so they are only
+// requested when insights is set, which RandomForestTrain() does.  The outputs
+// are read by the caller, which then clears the settings.
+func runRandomForest(param *RandomForestOptionalParam, insights bool) {
   resetTimers()
   enableTimers()
   disableBacktrace()
@@ -224,25 +270,21 @@ func RandomForest(param *RandomForestOptionalParam) (randomForestModel, *mat.Den
     enableVerbose()
   }
 
+  // Detect if the parameter was passed; set if so.
+  if param.WarmStart != false {
+    setParamBool("warm_start", param.WarmStart)
+    setPassed("warm_start")
+  }
+
   // Mark all output options as passed.
+  if insights {
+    setPassed("feature_importances")
+    setPassed("oob_accuracy")
+  }
   setPassed("output_model")
   setPassed("predictions")
   setPassed("probabilities")
 
   // Call the mlpack program.
   C.mlpackRandomForest()
-
-  // Initialize result variable and get output.
-  var outputModel randomForestModel
-  outputModel.getRandomForestModel("output_model")
-  var predictionsPtr mlpackArma
-  predictions := predictionsPtr.armaToGonumUrow("predictions")
-  var probabilitiesPtr mlpackArma
-  probabilities := probabilitiesPtr.armaToGonumMat("probabilities")
-
-  // Clear settings.
-  clearSettings()
-
-  // Return output(s).
-  return outputModel, predictions, probabilities
 }
@@ -0,0 +1,118 @@
+package mlpack
+
+import (
+  "errors"
+  "math/rand"
+
+  "gonum.org/v1/gonum/mat"
+)
+
+// Diagnostics of a random forest computed during training.  OOBAccuracy is the
+// accuracy of each training point's prediction by the trees whose bootstrap
+// sample did not contain it.  FeatureImportances holds the mean decrease in
+// impurity of each dimension, normalized to sum to 1.
+type RandomForestInsights struct {
+  OOBAccuracy float64
+  FeatureImportances []float64
+}
+
+// RandomForestTrain() trains a random forest with RandomForest() and returns
+// the trained model along with its out-of-bag accuracy and feature
+// importances.  The "Training" parameter must be given.  If "WarmStart" is
+// given, the out-of-bag accuracy only covers the new trees and training data,
+// while the feature importances cover the whole forest.
+func RandomForestTrain(param *RandomForestOptionalParam) (randomForestModel,
+    RandomForestInsights, error) {
+  if param.Training == nil {
+    return randomForestModel{}, RandomForestInsights{},
+        errors.New("training data must be given")
+  }
+  runRandomForest(param, true)
+
+  // Initialize result variable and get output.
+  var outputModel randomForestModel
+  outputModel.getRandomForestModel("output_model")
+  oobAccuracy := getParamDouble("oob_accuracy")
+  var featureImportancesPtr mlpackArma
+  featureImportances :=
+      featureImportancesPtr.armaToGonumRow("feature_importances")
+
+  // Clear settings.
+  clearSettings()
+
+  return outputModel, RandomForestInsights{
+    OOBAccuracy: oobAccuracy,
+    FeatureImportances: featureImportances.RawMatrix().Data,
+  }, nil
+}
+
+// PermutationImportance() returns the importance of each dimension of a
+// trained random forest, measured as the mean drop in accuracy on the given
+// validation set when the values of that dimension are randomly permuted.  The
+// permutation is repeated the given number of times with the given seed.
+func PermutationImportance(model *randomForestModel, validation *mat.Dense,
+                           labels *mat.Dense, repeats int,
+                           seed int64) ([]float64, error) {
+  n, d := validation.Dims()
+  truth := labelSlice(labels)
+  if len(truth) != n {
+    return nil, errors.New("there must be one label per validation point")
+  }
+  if repeats < 1 {
+    return nil, errors.New("repeats must be positive")
+  }
+
+  baseline := randomForestAccuracy(model, validation, truth)
+  rng := rand.New(rand.NewSource(seed))
+  permuted := mat.DenseCopyOf(validation)
+  column := make([]float64, n)
+  importances := make([]float64, d)
+  for j := 0; j < d; j++ {
+    mat.Col(column, j, validation)
+    for r := 0; r < repeats; r++ {
+      rng.Shuffle(n, func(a, b int) {
+        column[a], column[b] = column[b], column[a]
+      })
+      permuted.SetCol(j, column)
+      importances[j] += baseline - randomForestAccuracy(model, permuted, truth)
+    }
+    importances[j] /= float64(repeats)
+
+    // Restore the original column before permuting the next one.
+    mat.Col(column, j, validation)
+    permuted.SetCol(j, column)
+  }
+  return importances, nil
+}
+
+// randomForestAccuracy() returns the accuracy of the model on the given
+// points.
+func randomForestAccuracy(model *randomForestModel, points *mat.Dense,
+                          labels []float64) float64 {
+  param := RandomForestOptions()
+  param.InputModel = model
+  param.Test = points
+  _, predictions, _ := RandomForest(param)
+
+  n, _ := points.Dims()
+  correct := 0
+  for i := 0; i < n; i++ {
+    if predictions.At(i, 0) == labels[i] {
+      correct++
+    }
+  }
+  return float64(correct) / float64(n)
+}
+
+// labelSlice() returns the labels of a row or column vector, as accepted by the
+// "Labels" parameters of the bindings.
+func labelSlice(labels *mat.Dense) []float64 {
+  r, c := labels.Dims()
+  if r == 1 {
+    return mat.Row(nil, 0, labels)
+  }
+  if c == 1 {
+    return mat.Col(nil, 0, labels)
+  }
+  return nil
+}
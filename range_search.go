@@ -8,7 +8,11 @@ package mlpack
 */
 import "C" 
 
-import "gonum.org/v1/gonum/mat" 
+import (
+  "unsafe"
+
+  "gonum.org/v1/gonum/mat"
+)
 
 type RangeSearchOptionalParam struct {
     InputModel *rsModel
@@ -54,23 +58,25 @@ func RangeSearchOptions() *RangeSearchOptionalParam {
   results).
   
   For example, the following will calculate the points within the range [2, 5]
-  of each point in 'input.csv' and store the distances in 'distances.csv' and
-  the neighbors in 'neighbors.csv':
+  of each point in input and return the distances in distances and the
+  neighbors in neighbors:
+  
+  // Initialize optional parameters for RangeSearch().
+  param := mlpack.RangeSearchOptions()
+  param.Min = 2
+  param.Max = 5
+  param.Reference = input
   
-  $ range_search --min=2 --max=5 --reference_file=input.csv
-    --distances_file=distances.csv --neighbors_file=neighbors.csv
+  distances, neighbors, _ := mlpack.RangeSearch(param)
   
-  The output files are organized such that line i corresponds to the points
-  found for query point i.  Because sometimes 0 points may be found in the given
-  range, lines of the output files may be empty.  The points are not ordered in
+  The outputs are organized such that element i corresponds to the points found
+  for query point i.  Because sometimes 0 points may be found in the given
+  range, elements of the outputs may be empty.  The points are not ordered in
   any specific manner.
   
-  Because the number of points returned for each query point may differ, the
-  resultant CSV-like files may not be loadable by many programs.  However, at
-  this time a better way to store this non-square result is not known.  As a
-  result, any output files will be written as CSVs in this manner, regardless of
-  the given extension.
-
+  For very large result sets, RangeSearchStream() may be used instead; it hands
+  the results for each query point to a callback one at a time, so the full
+  ragged result never has to be held in Go memory.
 
   Input parameters:
 
@@ -99,15 +105,71 @@ func RangeSearchOptions() *RangeSearchOptionalParam {
 
   Output parameters:
 
-   - distancesFile (string): File to output distances into.  Default value
-        ''.
-   - neighborsFile (string): File to output neighbors into.  Default value
-        ''.
+   - distances ([][]float64): Distances to the points found in range, one
+        slice per query point.
+   - neighbors ([][]int): Indices of the points found in range, one slice
+        per query point.
    - outputModel (rsModel): If specified, the range search model will be
         saved to the given file.
 
  */
-func RangeSearch(param *RangeSearchOptionalParam) (string, string, rsModel) {
+func RangeSearch(param *RangeSearchOptionalParam) ([][]float64, [][]int, rsModel) {
+  runRangeSearch(param)
+
+  // Initialize result variable and get output.
+  distances := getParamVecVecDouble("distances")
+  neighbors := getParamVecVecInt("neighbors")
+  var outputModel rsModel
+  outputModel.getRSModel("output_model")
+
+  // Clear settings.
+  clearSettings()
+
+  // Return output(s).
+  return distances, neighbors, outputModel
+}
+
+/*
+  RangeSearchStream runs the same search as RangeSearch(), but instead of
+  returning every result at once it calls fn with the neighbors and distances of
+  each query point in turn, in query order.  Only one query point's results are
+  copied out of mlpack at a time.  If fn returns false, iteration stops early.
+  The range search model is returned as with RangeSearch().
+
+ */
+func RangeSearchStream(param *RangeSearchOptionalParam,
+                       fn func(query int, neighbors []int,
+                               distances []float64) bool) rsModel {
+  runRangeSearch(param)
+
+  // Hand the results for each query point to the caller.  The identifiers are
+  // converted to C strings once for the whole stream.
+  neighborsID := C.CString("neighbors")
+  defer C.free(unsafe.Pointer(neighborsID))
+  distancesID := C.CString("distances")
+  defer C.free(unsafe.Pointer(distancesID))
+  n := getParamVecVecIntSize("neighbors")
+  for i := 0; i < n; i++ {
+    if !fn(i, getParamVecVecIntAt(neighborsID, i),
+           getParamVecVecDoubleAt(distancesID, i)) {
+      break
+    }
+  }
+
+  var outputModel rsModel
+  outputModel.getRSModel("output_model")
+
+  // Clear settings.
+  clearSettings()
+
+  // Return output(s).
+  return outputModel
+}
+
+// runRangeSearch runs the range search.  The nested result vectors stay in
+// mlpack's memory until the settings are cleared, so RangeSearch() can copy
+// them all at once and RangeSearchStream() one query point at a time.
+func runRangeSearch(param *RangeSearchOptionalParam) {
   resetTimers()
   enableTimers()
   disableBacktrace()
@@ -188,22 +250,10 @@ func RangeSearch(param *RangeSearchOptionalParam) (string, string, rsModel) {
   }
 
   // Mark all output options as passed.
-  setPassed("distances_file")
-  setPassed("neighbors_file")
+  setPassed("distances")
+  setPassed("neighbors")
   setPassed("output_model")
 
   // Call the mlpack program.
   C.mlpackRangeSearch()
-
-  // Initialize result variable and get output.
-  distancesFile := getParamString("distances_file")
-  neighborsFile := getParamString("neighbors_file")
-  var outputModel rsModel
-  outputModel.getRSModel("output_model")
-
-  // Clear settings.
-  clearSettings()
-
-  // Return output(s).
-  return distancesFile, neighborsFile, outputModel
 }
@@ -60,9 +60,11 @@ func SoftmaxRegressionOptions() *SoftmaxRegressionOptionalParam {
   
   The program is also able to evaluate a model on test data.  A test dataset can
   be specified with the "Test" parameter. Class predictions can be saved with
-  the "Predictions" output parameter.  If labels are specified for the test data
-  with the "TestLabels" parameter, then the program will print the accuracy of
-  the predictions on the given test set and its corresponding labels.
+  the "Predictions" output parameter, and the probability of each class for each
+  test point with the "Probabilities" output parameter.  If labels are specified
+  for the test data with the "TestLabels" parameter, then the program will print
+  the accuracy of the predictions on the given test set and its corresponding
+  labels.
   
   For example, to train a softmax regression model on the data dataset with
   labels labels with a maximum of 1000 iterations for training, saving the
@@ -73,7 +75,7 @@ func SoftmaxRegressionOptions() *SoftmaxRegressionOptionalParam {
   param.Training = dataset
   param.Labels = labels
   
-  sr_model, _ := mlpack.SoftmaxRegression(param)
+  sr_model, _, _ := mlpack.SoftmaxRegression(param)
   
   Then, to use sr_model to classify the test points in test_points, saving the
   output predictions to predictions, the following command can be used:
@@ -83,7 +85,7 @@ func SoftmaxRegressionOptions() *SoftmaxRegressionOptionalParam {
   param.InputModel = &sr_model
   param.Test = test_points
   
-  _, predictions := mlpack.SoftmaxRegression(param)
+  _, predictions, _ := mlpack.SoftmaxRegression(param)
 
 
   Input parameters:
@@ -112,9 +114,11 @@ func SoftmaxRegressionOptions() *SoftmaxRegressionOptionalParam {
         regression model to.
    - predictions (mat.Dense): Matrix to save predictions for test dataset
         into.
+   - probabilities (mat.Dense): Matrix to save class probabilities for
+        test dataset into, one row per test point.
 
  */
-func SoftmaxRegression(param *SoftmaxRegressionOptionalParam) (softmaxRegression, *mat.Dense) {
+func SoftmaxRegression(param *SoftmaxRegressionOptionalParam) (softmaxRegression, *mat.Dense, *mat.Dense) {
   resetTimers()
   enableTimers()
   disableBacktrace()
@@ -185,6 +189,7 @@ func SoftmaxRegression(param *SoftmaxRegressionOptionalParam) (softmaxRegression
   // Mark all output options as passed.
   setPassed("output_model")
   setPassed("predictions")
+  setPassed("probabilities")
 
   // Call the mlpack program.
   C.mlpackSoftmaxRegression()
@@ -194,10 +199,12 @@ func SoftmaxRegression(param *SoftmaxRegressionOptionalParam) (softmaxRegression
   outputModel.getSoftmaxRegression("output_model")
   var predictionsPtr mlpackArma
   predictions := predictionsPtr.armaToGonumUrow("predictions")
+  var probabilitiesPtr mlpackArma
+  probabilities := probabilitiesPtr.armaToGonumMat("probabilities")
 
   // Clear settings.
   clearSettings()
 
   // Return output(s).
-  return outputModel, predictions
+  return outputModel, predictions, probabilities
 }
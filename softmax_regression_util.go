@@ -0,0 +1,59 @@
+package mlpack
+
+/*
+#cgo CFLAGS: -I./capi -Wall
+#cgo LDFLAGS: -L. -lmlpack_go_softmax_regression
+#include <capi/softmax_regression.h>
+#include <stdlib.h>
+*/
+import "C"
+
+import (
+  "unsafe"
+
+  "gonum.org/v1/gonum/mat"
+)
+
+// NumClasses() returns the number of classes of the model.
+func (m *softmaxRegression) NumClasses() int {
+  return int(C.mlpackSoftmaxRegressionNumClasses(m.mem))
+}
+
+// HasIntercept() returns whether the model was trained with an intercept, that
+// is, without the "NoIntercept" parameter.
+func (m *softmaxRegression) HasIntercept() bool {
+  return bool(C.mlpackSoftmaxRegressionFitIntercept(m.mem))
+}
+
+// Parameters() returns a copy of the parameters of the model, one row per
+// class.  If the model has an intercept, it is the first column.
+func (m *softmaxRegression) Parameters() *mat.Dense {
+  // The parameters are stored as a column-major classes x columns matrix.
+  k := m.NumClasses()
+  cols := int(C.mlpackSoftmaxRegressionParametersCols(m.mem))
+  data := copyDoubles(
+      unsafe.Pointer(C.mlpackSoftmaxRegressionParameters(m.mem)), k * cols)
+  output := mat.NewDense(k, cols, nil)
+  output.Copy(mat.NewDense(cols, k, data).T())
+  return output
+}
+
+// Weights() returns the weights of each class, one row per class and one
+// column per dimension.
+func (m *softmaxRegression) Weights() *mat.Dense {
+  params := m.Parameters()
+  if !m.HasIntercept() {
+    return params
+  }
+  k, cols := params.Dims()
+  return mat.DenseCopyOf(params.Slice(0, k, 1, cols))
+}
+
+// Intercepts() returns the intercept of each class, or zeros if the model has
+// no intercept.
+func (m *softmaxRegression) Intercepts() []float64 {
+  if !m.HasIntercept() {
+    return make([]float64, m.NumClasses())
+  }
+  return mat.Col(nil, 0, m.Parameters())
+}
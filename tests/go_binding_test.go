@@ -1,7 +1,12 @@
 package main
 
 import (
+	"bytes"
+	"encoding/json"
 	"github.com/Yashwants19/v1"
+	"image"
+	"math"
+	"sort"
 	"testing"
 	"os"
 
@@ -674,3 +679,570 @@ func TestLoadSaveRow(t *testing.T) {
   }
   os.Remove("test_row.csv")
 }
+
+func TestDendrogram(t *testing.T) {
+  t.Log("Test that a dendrogram built from a spanning tree is cut and",
+        "exported correctly.")
+  spanningTree := mat.NewDense(3, 3, []float64{
+    0, 1, 1,
+    1, 2, 4,
+    2, 3, 1.5,
+  })
+  d, err := mlpack.NewDendrogram(spanningTree)
+  if err != nil {
+    t.Fatalf("Error. %v", err)
+  }
+
+  cuts := []struct {
+    distance float64
+    labels []int
+  }{
+    {0.5, []int{0, 1, 2, 3}},
+    {1, []int{0, 0, 1, 2}},
+    {2, []int{0, 0, 1, 1}},
+    {4, []int{0, 0, 0, 0}},
+  }
+  for _, cut := range cuts {
+    labels := d.CutDistance(cut.distance)
+    for i := range labels {
+      if labels[i] != cut.labels[i] {
+        t.Errorf("Error. Wrong labels at distance %v: %v", cut.distance,
+                 labels)
+        break
+      }
+    }
+  }
+
+  labels, err := d.CutClusters(3)
+  if err != nil || len(labels) != 4 || labels[0] != labels[1] ||
+      labels[2] == labels[3] {
+    t.Errorf("Error. Wrong labels for 3 clusters: %v", labels)
+  }
+  if _, err := d.CutClusters(0); err == nil {
+    t.Errorf("Error. No error for 0 clusters.")
+  }
+
+  newick, _ := d.Newick(nil)
+  if newick != "((0:1,1:1):3,(2:1.5,3:1.5):2.5):0;" {
+    t.Errorf("Error. Wrong Newick output: %v", newick)
+  }
+  newick, _ = d.Newick([]string{"a", "b c", "d", "e"})
+  if newick != "((a:1,'b c':1):3,(d:1.5,e:1.5):2.5):0;" {
+    t.Errorf("Error. Wrong Newick output with names: %v", newick)
+  }
+
+  var root mlpack.DendrogramNode
+  output, _ := d.JSON()
+  if err := json.Unmarshal(output, &root); err != nil {
+    t.Fatalf("Error. %v", err)
+  }
+  if root.Size != 4 || root.Distance != 4 || len(root.Children) != 2 ||
+      root.Children[0].Children[1].Point == nil ||
+      *root.Children[0].Children[1].Point != 1 {
+    t.Errorf("Error. Wrong JSON output: %s", output)
+  }
+}
+
+func TestLarsPathAt(t *testing.T) {
+  t.Log("Test that the coefficients of a LARS path are interpolated",
+        "linearly between its lambdas.")
+  path := mlpack.LarsPath{
+    Coefficients: mat.NewDense(3, 2, []float64{
+      0, 0,
+      1, 0,
+      3, 2,
+    }),
+    Lambdas: mat.NewDense(3, 1, []float64{2, 1, 0}),
+  }
+
+  cases := []struct {
+    lambda float64
+    beta []float64
+  }{
+    {3, []float64{0, 0}},
+    {2, []float64{0, 0}},
+    {1.5, []float64{0.5, 0}},
+    {1, []float64{1, 0}},
+    {0.5, []float64{2, 1}},
+    {-1, []float64{3, 2}},
+  }
+  for _, c := range cases {
+    beta := path.At(c.lambda)
+    if len(beta) != 2 || math.Abs(beta[0] - c.beta[0]) > 1e-12 ||
+        math.Abs(beta[1] - c.beta[1]) > 1e-12 {
+      t.Errorf("Error. Wrong coefficients at lambda %v: %v", c.lambda, beta)
+    }
+  }
+
+  var empty mlpack.LarsPath
+  if empty.Steps() != 0 || empty.At(1) != nil {
+    t.Errorf("Error. Wrong result for an empty path.")
+  }
+}
+
+func TestDecisionTreeNodeExport(t *testing.T) {
+  t.Log("Test that a decision tree counts samples and is exported to DOT and",
+        "JSON correctly.")
+  tree := &mlpack.DecisionTreeNode{
+    SplitDimension: 1,
+    SplitValue: 2.5,
+    Children: []*mlpack.DecisionTreeNode{
+      {ClassProbabilities: []float64{1, 0}},
+      {
+        SplitDimension: 0,
+        Categorical: true,
+        Children: []*mlpack.DecisionTreeNode{
+          {ClassProbabilities: []float64{0, 1}},
+          {ClassProbabilities: []float64{0.5, 0.5}},
+        },
+      },
+    },
+  }
+  tree.CountSamples(mat.NewDense(3, 2, []float64{
+    0, 1,
+    0, 3,
+    1, 4,
+  }))
+
+  usage := tree.FeatureUsage()
+  if len(usage) != 2 || usage[0] != 1 || usage[1] != 1 {
+    t.Errorf("Error. Wrong feature usage: %v", usage)
+  }
+
+  expected := `digraph DecisionTree {
+  node [shape=box];
+  n0 [label="petal <= 2.5\nsamples = 3"];
+  n1 [label="probabilities = [1 0]\nsamples = 1"];
+  n0 -> n1 [label="yes"];
+  n2 [label="color (categorical)\nsamples = 2"];
+  n3 [label="probabilities = [0 1]\nsamples = 1"];
+  n2 -> n3 [label="0"];
+  n4 [label="probabilities = [0.5 0.5]\nsamples = 1"];
+  n2 -> n4 [label="1"];
+  n0 -> n2 [label="no"];
+}
+`
+  if dot := tree.DOT([]string{"color", "petal"}); dot != expected {
+    t.Errorf("Error. Wrong DOT output:\n%s", dot)
+  }
+
+  var root mlpack.DecisionTreeNode
+  output, _ := tree.JSON()
+  if err := json.Unmarshal(output, &root); err != nil {
+    t.Fatalf("Error. %v", err)
+  }
+  if root.SplitDimension != 1 || root.SplitValue != 2.5 ||
+      root.NumSamples != 3 || len(root.Children) != 2 ||
+      !root.Children[1].Categorical ||
+      root.Children[1].Children[1].ClassProbabilities[0] != 0.5 {
+    t.Errorf("Error. Wrong JSON output: %s", output)
+  }
+}
+
+func TestImageMatrixRoundTrip(t *testing.T) {
+  t.Log("Test that in-memory images are converted to a matrix and back",
+        "without loss.")
+  rgba := image.NewNRGBA(image.Rect(0, 0, 2, 2))
+  for k := range rgba.Pix {
+    rgba.Pix[k] = uint8(k * 15)
+  }
+  gray := image.NewGray(image.Rect(0, 0, 3, 1))
+  copy(gray.Pix, []uint8{0, 128, 255})
+
+  param := mlpack.ImageConverterOptions()
+  m, err := mlpack.ImagesToMatrix([]image.Image{rgba}, param)
+  if err != nil {
+    t.Fatalf("Error. %v", err)
+  }
+  if r, c := m.Dims(); r != 1 || c != 16 || m.At(0, 5) != 75 {
+    t.Errorf("Error. Wrong matrix for an RGBA image.")
+  }
+  param.Width, param.Height, param.Channels = 2, 2, 4
+  images, err := mlpack.MatrixToImages(m, param)
+  if err != nil {
+    t.Fatalf("Error. %v", err)
+  }
+  if out, ok := images[0].(*image.NRGBA); !ok ||
+      !bytes.Equal(out.Pix, rgba.Pix) {
+    t.Errorf("Error. Wrong image after the round trip.")
+  }
+
+  m, _ = mlpack.ImagesToMatrix([]image.Image{gray}, nil)
+  param = mlpack.ImageConverterOptions()
+  param.Width, param.Height, param.Channels = 3, 1, 1
+  images, _ = mlpack.MatrixToImages(m, param)
+  if out, ok := images[0].(*image.Gray); !ok ||
+      !bytes.Equal(out.Pix, gray.Pix) {
+    t.Errorf("Error. Wrong grayscale image after the round trip.")
+  }
+
+  translucent := image.NewNRGBA(image.Rect(0, 0, 1, 1))
+  copy(translucent.Pix, []uint8{200, 200, 200, 128})
+  param = mlpack.ImageConverterOptions()
+  param.Channels = 2
+  m, _ = mlpack.ImagesToMatrix([]image.Image{translucent}, param)
+  if m.At(0, 0) != 200 || m.At(0, 1) != 128 {
+    t.Errorf("Error. Wrong gray and alpha of a translucent pixel: %v",
+             mat.Formatted(m))
+  }
+
+  param.Width, param.Height, param.Channels = 3, 1, 1
+  images, _ = mlpack.MatrixToImages(mat.NewDense(1, 3, []float64{
+    -5, 127.6, 300,
+  }), param)
+  if out := images[0].(*image.Gray);
+      !bytes.Equal(out.Pix, []uint8{0, 128, 255}) {
+    t.Errorf("Error. Values not rounded and clamped: %v", out.Pix)
+  }
+
+  param = mlpack.ImageConverterOptions()
+  if _, err := mlpack.ImagesToMatrix([]image.Image{rgba, gray},
+                                     param); err == nil {
+    t.Errorf("Error. No error for images of different sizes.")
+  }
+}
+
+func TestDescribeQuantiles(t *testing.T) {
+  t.Log("Test that quantiles are computed per dimension and that invalid",
+        "dimensions and quantiles are rejected.")
+  input := mat.NewDense(5, 2, []float64{
+    3, 50,
+    1, 40,
+    5, 30,
+    2, 20,
+    4, 10,
+  })
+  quantiles := []float64{0, 1}
+
+  param := mlpack.PreprocessDescribeOptions()
+  output, err := mlpack.DescribeQuantiles(input, quantiles, param)
+  if err != nil {
+    t.Fatalf("Error. %v", err)
+  }
+  expected := mat.NewDense(2, 2, []float64{
+    1, 5,
+    10, 50,
+  })
+  if !mat.Equal(output, expected) {
+    t.Errorf("Error. Wrong quantiles: %v", mat.Formatted(output))
+  }
+  if output, _ := mlpack.DescribeQuantiles(input, quantiles, nil);
+      !mat.Equal(output, expected) {
+    t.Errorf("Error. Wrong quantiles with the default parameters.")
+  }
+
+  param.Dimension = 1
+  output, _ = mlpack.DescribeQuantiles(input, quantiles, param)
+  if r, _ := output.Dims(); r != 1 || output.At(0, 1) != 50 {
+    t.Errorf("Error. Wrong quantiles of dimension 1.")
+  }
+
+  param.Dimension = 2
+  if _, err := mlpack.DescribeQuantiles(input, quantiles, param); err == nil {
+    t.Errorf("Error. No error for an out-of-range dimension.")
+  }
+  param.Dimension = 0
+  if _, err := mlpack.DescribeQuantiles(input, []float64{0.5, 1.5},
+                                        param); err == nil {
+    t.Errorf("Error. No error for a quantile above 1.")
+  }
+}
+
+func TestCfEvaluateSplit(t *testing.T) {
+  t.Log("Test that CfEvaluate() rejects invalid splits and drops held-out",
+        "ratings of items missing from the training set.")
+  ratings := mat.NewDense(4, 4, []float64{
+    0, 0, 5, 1,
+    0, 1, 3, 2,
+    1, 2, 4, 1,
+    1, 3, 2, 2,
+  })
+
+  param := mlpack.CfEvaluateOptions()
+  param.TestRatio = 1
+  if _, _, err := mlpack.CfEvaluate(ratings, param); err == nil {
+    t.Errorf("Error. No error for a test ratio of 1.")
+  }
+  param = mlpack.CfEvaluateOptions()
+  param.Split = "item"
+  if _, _, err := mlpack.CfEvaluate(ratings, param); err == nil {
+    t.Errorf("Error. No error for an unknown split.")
+  }
+  param = mlpack.CfEvaluateOptions()
+  param.Split = "time"
+  if _, _, err := mlpack.CfEvaluate(ratings.Slice(0, 4, 0, 3).(*mat.Dense),
+                                    param); err == nil {
+    t.Errorf("Error. No error for a 'time' split without timestamps.")
+  }
+
+  // The latest rating of each user is of an item nobody else rated, so every
+  // held-out rating must be dropped.
+  param.TestRatio = 0.5
+  if _, _, err := mlpack.CfEvaluate(ratings, param); err == nil {
+    t.Errorf("Error. Held-out ratings of unknown items were kept.")
+  }
+  if _, _, err := mlpack.CfEvaluate(ratings, nil); err == nil {
+    t.Errorf("Error. Held-out ratings of unknown items were kept with the " +
+             "default parameters.")
+  }
+}
+
+func TestCfEvaluateMetrics(t *testing.T) {
+  t.Log("Test that the metrics of CfEvaluate() are in their valid ranges.")
+  data := make([]float64, 0, 3 * 8 * 6)
+  for u := 0; u < 8; u++ {
+    for i := 0; i < 6; i++ {
+      data = append(data, float64(u), float64(i), float64(1 + (u + i) % 5))
+    }
+  }
+
+  param := mlpack.CfEvaluateOptions()
+  param.K = 3
+  param.RelevanceThreshold = 3
+  param.Seed = 1
+  param.Train.Rank = 2
+  metrics, _, err := mlpack.CfEvaluate(mat.NewDense(48, 3, data), param)
+  if err != nil {
+    t.Fatalf("Error. %v", err)
+  }
+  if metrics.RMSE < 0 || metrics.MAE < 0 || metrics.MAE > metrics.RMSE {
+    t.Errorf("Error. Wrong rating metrics: %+v", metrics)
+  }
+  for _, v := range []float64{metrics.Precision, metrics.Recall, metrics.MAP,
+                              metrics.NDCG} {
+    if v < 0 || v > 1 {
+      t.Errorf("Error. Ranking metric out of range: %+v", metrics)
+    }
+  }
+}
+
+func TestOutlierDetectorLOF(t *testing.T) {
+  t.Log("Test that the outlier scores of the reference points match a",
+        "brute-force computation.")
+  // Pairwise distances are distinct, so the neighbors are unambiguous.
+  x := []float64{0, 1, 3, 7, 20}
+  n, k := len(x), 2
+  reference := mat.NewDense(n, 2, nil)
+  for i, v := range x {
+    reference.Set(i, 0, v)
+  }
+
+  // The k nearest neighbors of each point, excluding itself.
+  neighbors := make([][]int, n)
+  for i := range neighbors {
+    for j := 0; j < n; j++ {
+      if j != i {
+        neighbors[i] = append(neighbors[i], j)
+      }
+    }
+    sort.Slice(neighbors[i], func(a, b int) bool {
+      return math.Abs(x[neighbors[i][a]] - x[i]) <
+          math.Abs(x[neighbors[i][b]] - x[i])
+    })
+    neighbors[i] = neighbors[i][:k]
+  }
+  kDistance := make([]float64, n)
+  meanDistance := make([]float64, n)
+  for i := range kDistance {
+    kDistance[i] = math.Abs(x[neighbors[i][k - 1]] - x[i])
+    for _, j := range neighbors[i] {
+      meanDistance[i] += math.Abs(x[j] - x[i]) / float64(k)
+    }
+  }
+  lrd := make([]float64, n)
+  for i := range lrd {
+    sum := 0.0
+    for _, j := range neighbors[i] {
+      sum += math.Max(kDistance[j], math.Abs(x[j] - x[i]))
+    }
+    lrd[i] = 1 / (sum / float64(k) + 1e-10)
+  }
+  lof := make([]float64, n)
+  for i := range lof {
+    for _, j := range neighbors[i] {
+      lof[i] += lrd[j] / float64(k) / lrd[i]
+    }
+  }
+
+  detector, err := mlpack.NewOutlierDetector(reference, k, nil)
+  if err != nil {
+    t.Fatalf("Error. %v", err)
+  }
+  expected := map[string][]float64{
+    "lof": lof,
+    "kdist": kDistance,
+    "mean_kdist": meanDistance,
+  }
+  for method, values := range expected {
+    scores, err := detector.Scores(nil, method)
+    if err != nil || len(scores) != n {
+      t.Fatalf("Error. Wrong %s scores: %v", method, err)
+    }
+    for i := range scores {
+      if math.Abs(scores[i] - values[i]) > 1e-6 * math.Max(1, values[i]) {
+        t.Errorf("Error. Wrong %s score of point %d: %v, expected %v",
+                 method, i, scores[i], values[i])
+      }
+    }
+  }
+
+  if _, err := detector.Scores(nil, "knn"); err == nil {
+    t.Errorf("Error. No error for an unknown method.")
+  }
+  if _, err := mlpack.NewOutlierDetector(reference, n, nil); err == nil {
+    t.Errorf("Error. No error for k equal to the number of points.")
+  }
+}
+
+func TestKmeansModel(t *testing.T) {
+  t.Log("Test that a k-means model built from centroids assigns and",
+        "transforms points, rejects bad input and round-trips through",
+        "serialization.")
+  model := mlpack.NewKmeansModel(mat.NewDense(2, 2, []float64{
+    0, 0,
+    3, 4,
+  }))
+  points := mat.NewDense(3, 2, []float64{
+    1, 1,
+    3, 3,
+    0, 4,
+  })
+
+  assignments, err := model.Assign(points)
+  if err != nil {
+    t.Fatalf("Error. %v", err)
+  }
+  if assignments[0] != 0 || assignments[1] != 1 || assignments[2] != 1 {
+    t.Errorf("Error. Wrong assignments: %v", assignments)
+  }
+
+  distances, err := model.Transform(points)
+  if err != nil {
+    t.Fatalf("Error. %v", err)
+  }
+  expected := mat.NewDense(3, 2, []float64{
+    math.Sqrt(2), math.Sqrt(13),
+    math.Sqrt(18), 1,
+    4, 3,
+  })
+  if !mat.EqualApprox(distances, expected, 1e-12) {
+    t.Errorf("Error. Wrong distances: %v", mat.Formatted(distances))
+  }
+
+  if _, err := model.Assign(mat.NewDense(1, 3, nil)); err == nil {
+    t.Errorf("Error. No error for points of the wrong dimensionality.")
+  }
+  if _, err := mlpack.NewKmeansModel(nil).Transform(points); err == nil {
+    t.Errorf("Error. No error for a model without centroids.")
+  }
+
+  data, err := model.MarshalBinary()
+  if err != nil {
+    t.Fatalf("Error. %v", err)
+  }
+  restored := mlpack.NewKmeansModel(nil)
+  if err := restored.UnmarshalBinary(data); err != nil {
+    t.Fatalf("Error. %v", err)
+  }
+  if !mat.Equal(restored.Centroids, model.Centroids) {
+    t.Errorf("Error. Wrong centroids after deserialization.")
+  }
+}
+
+func TestNewGMMValidation(t *testing.T) {
+  t.Log("Test that NewGMM() rejects inconsistent parameters.")
+  means := []*mat.VecDense{
+    mat.NewVecDense(2, []float64{0, 0}),
+    mat.NewVecDense(2, []float64{1, 1}),
+  }
+  covs := []*mat.SymDense{
+    mat.NewSymDense(2, []float64{1, 0, 0, 1}),
+    mat.NewSymDense(2, []float64{1, 0, 0, 1}),
+  }
+
+  cases := []struct {
+    name string
+    weights []float64
+    means []*mat.VecDense
+    covs []*mat.SymDense
+  }{
+    {"no components", nil, nil, nil},
+    {"a length mismatch", []float64{1}, means, covs},
+    {"weights not summing to 1", []float64{0.5, 0.6}, means, covs},
+    {"a nil mean", []float64{0.5, 0.5}, []*mat.VecDense{means[0], nil}, covs},
+    {"a nil covariance", []float64{0.5, 0.5}, means,
+     []*mat.SymDense{nil, covs[1]}},
+    {"a dimension mismatch", []float64{0.5, 0.5},
+     []*mat.VecDense{means[0], mat.NewVecDense(3, nil)}, covs},
+  }
+  for _, c := range cases {
+    if _, err := mlpack.NewGMM(c.weights, c.means, c.covs); err == nil {
+      t.Errorf("Error. No error for %s.", c.name)
+    }
+  }
+}
+
+func TestNewHMMValidation(t *testing.T) {
+  t.Log("Test that the HMM constructors reject inconsistent parameters and",
+        "probabilities that do not sum to 1.")
+  initial := []float64{0.5, 0.5}
+  transition := mat.NewDense(2, 2, []float64{
+    0.9, 0.1,
+    0.2, 0.8,
+  })
+  emission := mat.NewDense(2, 3, []float64{
+    0.5, 0.25, 0.25,
+    0.1, 0.1, 0.8,
+  })
+
+  discrete := []struct {
+    name string
+    initial []float64
+    transition *mat.Dense
+    emission *mat.Dense
+  }{
+    {"no states", nil, transition, emission},
+    {"initial probabilities not summing to 1", []float64{0.5, 0.6},
+     transition, emission},
+    {"a transition row not summing to 1", initial,
+     mat.NewDense(2, 2, []float64{0.9, 0.2, 0.2, 0.8}), emission},
+    {"a non-square transition matrix", initial,
+     mat.NewDense(2, 3, []float64{0.5, 0.5, 0, 0.5, 0.5, 0}), emission},
+    {"a nil transition matrix", initial, nil, emission},
+    {"an emission row not summing to 1", initial, transition,
+     mat.NewDense(2, 3, []float64{0.5, 0.25, 0.25, 0.1, 0.1, 0.1})},
+    {"an emission matrix with the wrong number of rows", initial, transition,
+     mat.NewDense(1, 3, []float64{0.5, 0.25, 0.25})},
+    {"a nil emission matrix", initial, transition, nil},
+  }
+  for _, c := range discrete {
+    if _, err := mlpack.NewDiscreteHMM(c.initial, c.transition,
+                                       c.emission); err == nil {
+      t.Errorf("Error. No error for %s.", c.name)
+    }
+  }
+
+  mean := mat.NewVecDense(2, []float64{0, 0})
+  cov := mat.NewSymDense(2, []float64{1, 0, 0, 1})
+  gaussian := []struct {
+    name string
+    means []*mat.VecDense
+    covs []*mat.SymDense
+  }{
+    {"a missing mean", []*mat.VecDense{mean}, []*mat.SymDense{cov, cov}},
+    {"a nil covariance", []*mat.VecDense{mean, mean},
+     []*mat.SymDense{cov, nil}},
+    {"a dimension mismatch", []*mat.VecDense{mean, mat.NewVecDense(3, nil)},
+     []*mat.SymDense{cov, cov}},
+  }
+  for _, c := range gaussian {
+    if _, err := mlpack.NewGaussianHMM(initial, transition, c.means,
+                                       c.covs); err == nil {
+      t.Errorf("Error. No error for %s.", c.name)
+    }
+  }
+
+  if _, err := mlpack.NewGMMHMM(initial, transition, nil, false); err == nil {
+    t.Errorf("Error. No error for missing emission GMMs.")
+  }
+}